@@ -0,0 +1,94 @@
+package countrycontinent
+
+import (
+	"context"
+	"errors"
+)
+
+// BatchResult is the outcome of looking up one country code of a batch.
+type BatchResult struct {
+	CountryCode string // The country code as given
+	CountryName string // Empty if Err is not nil
+	Continent   string // Empty if Err is not nil
+	Err         error  // *InvalidCountryCodeError, *CountryNotFoundError or nil
+}
+
+// BatchSummary counts the outcomes of a batch lookup.
+type BatchSummary struct {
+	Total    int // Number of codes looked up
+	Found    int // Number of codes found
+	Invalid  int // Number of malformed codes
+	NotFound int // Number of well-formed codes missing from the registry
+}
+
+// Add counts the given result in the summary.
+func (s *BatchSummary) Add(result BatchResult) {
+	s.Total++
+	switch {
+	case result.Err == nil:
+		s.Found++
+	case errors.Is(result.Err, ErrInvalidCountryCode):
+		s.Invalid++
+	case errors.Is(result.Err, ErrCountryNotFound):
+		s.NotFound++
+	}
+}
+
+// lookupBatchItem looks up the full name and continent of a single country code.
+func (r *Registry) lookupBatchItem(countryCode string) BatchResult {
+	name, continent, err := r.CountryGetFullNameContinent(countryCode)
+	return BatchResult{CountryCode: countryCode, CountryName: name, Continent: continent, Err: err}
+}
+
+// CountryGetFullNameContinentBatch looks up the full name and continent of each country code.
+// The results are aligned by index with the codes, each with its own error, and the summary
+// counts how many codes were found, invalid or unknown.
+func (r *Registry) CountryGetFullNameContinentBatch(countryCodes []string) ([]BatchResult, BatchSummary) {
+	results := make([]BatchResult, len(countryCodes))
+	var summary BatchSummary
+	for i, code := range countryCodes {
+		results[i] = r.lookupBatchItem(code)
+		summary.Add(results[i])
+	}
+	return results, summary
+}
+
+// CountryGetFullNameContinentStream looks up the full name and continent of each country code
+// received from countryCodes and sends the results, in the same order, on the returned channel.
+// The returned channel is closed once countryCodes is closed or ctx is done.
+func (r *Registry) CountryGetFullNameContinentStream(ctx context.Context, countryCodes <-chan string) <-chan BatchResult {
+	results := make(chan BatchResult)
+	go func() {
+		defer close(results)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case code, ok := <-countryCodes:
+				if !ok {
+					return
+				}
+				select {
+				case results <- r.lookupBatchItem(code):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return results
+}
+
+// CountryGetFullNameContinentBatch looks up the full name and continent of each country code.
+// The results are aligned by index with the codes, each with its own error, and the summary
+// counts how many codes were found, invalid or unknown.
+func CountryGetFullNameContinentBatch(countryCodes []string) ([]BatchResult, BatchSummary) {
+	return defaultRegistry.CountryGetFullNameContinentBatch(countryCodes)
+}
+
+// CountryGetFullNameContinentStream looks up the full name and continent of each country code
+// received from countryCodes and sends the results, in the same order, on the returned channel.
+// The returned channel is closed once countryCodes is closed or ctx is done.
+func CountryGetFullNameContinentStream(ctx context.Context, countryCodes <-chan string) <-chan BatchResult {
+	return defaultRegistry.CountryGetFullNameContinentStream(ctx, countryCodes)
+}
@@ -0,0 +1,72 @@
+package countrycontinent
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCountryGetFullNameContinentBatch(t *testing.T) {
+	codes := []string{"FR", "fr", "XX", "MQ", "", "FR"}
+	want := []BatchResult{
+		{CountryCode: "FR", CountryName: "France", Continent: "Europe"},
+		{CountryCode: "fr", Err: &InvalidCountryCodeError{CountryCode: "fr"}},
+		{CountryCode: "XX", Err: &CountryNotFoundError{CountryCode: "XX"}},
+		{CountryCode: "MQ", CountryName: "Martinique", Continent: "Caribbean"},
+		{CountryCode: "", Err: &InvalidCountryCodeError{CountryCode: ""}},
+		{CountryCode: "FR", CountryName: "France", Continent: "Europe"},
+	}
+	results, summary := CountryGetFullNameContinentBatch(codes)
+	if len(results) != len(want) {
+		t.Fatalf("CountryGetFullNameContinentBatch() returned %d results; want %d", len(results), len(want))
+	}
+	for i, got := range results {
+		if got.CountryCode != want[i].CountryCode || got.CountryName != want[i].CountryName ||
+			got.Continent != want[i].Continent || !sameError(got.Err, want[i].Err) {
+			t.Errorf("result %d = %+v; want %+v", i, got, want[i])
+		}
+	}
+	wantSummary := BatchSummary{Total: 6, Found: 3, Invalid: 2, NotFound: 1}
+	if summary != wantSummary {
+		t.Errorf("summary = %+v; want %+v", summary, wantSummary)
+	}
+
+	if results, summary := CountryGetFullNameContinentBatch(nil); len(results) != 0 || summary != (BatchSummary{}) {
+		t.Errorf("CountryGetFullNameContinentBatch(nil) = %v, %+v; want no results", results, summary)
+	}
+}
+
+func TestCountryGetFullNameContinentStream(t *testing.T) {
+	codes := []string{"FR", "fr", "XX", "MQ"}
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, code := range codes {
+			in <- code
+		}
+	}()
+
+	var summary BatchSummary
+	var got []string
+	for result := range CountryGetFullNameContinentStream(context.Background(), in) {
+		summary.Add(result)
+		got = append(got, result.CountryCode)
+	}
+	if !reflect.DeepEqual(got, codes) {
+		t.Errorf("stream results = %v; want %v", got, codes)
+	}
+	wantSummary := BatchSummary{Total: 4, Found: 2, Invalid: 1, NotFound: 1}
+	if summary != wantSummary {
+		t.Errorf("summary = %+v; want %+v", summary, wantSummary)
+	}
+}
+
+func TestCountryGetFullNameContinentStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	results := CountryGetFullNameContinentStream(ctx, in)
+	cancel()
+	for range results {
+	}
+	close(in)
+}
@@ -0,0 +1,201 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// ErrInvalidPhoneNumber matches InvalidPhoneNumberError with errors.Is.
+var ErrInvalidPhoneNumber = errors.New("invalid phone number")
+
+// InvalidPhoneNumberError is returned when a phone number is not in the E.164 format.
+// It matches ErrInvalidPhoneNumber.
+type InvalidPhoneNumberError struct {
+	Number string
+}
+
+func (e *InvalidPhoneNumberError) Error() string {
+	return fmt.Sprintf("invalid phone number format: %s", e.Number)
+}
+
+// Is reports whether target is ErrInvalidPhoneNumber.
+func (e *InvalidPhoneNumberError) Is(target error) bool {
+	return target == ErrInvalidPhoneNumber
+}
+
+// PhoneNumberNotFoundError is returned when a phone number does not start with the calling code
+// of any country. It matches ErrCountryNotFound.
+type PhoneNumberNotFoundError struct {
+	Number string
+}
+
+func (e *PhoneNumberNotFoundError) Error() string {
+	return fmt.Sprintf("no country found for phone number: %s", e.Number)
+}
+
+// Is reports whether target is ErrCountryNotFound.
+func (e *PhoneNumberNotFoundError) Is(target error) bool {
+	return target == ErrCountryNotFound
+}
+
+var e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// callingCodes holds the E.164 international calling code of each country, without the leading
+// "+", keyed by alpha-2 country code. The French Southern Territories and the United States Minor
+// Outlying Islands have no calling code of their own.
+var callingCodes = map[string]string{
+	"AD": "376", "AE": "971", "AF": "93", "AG": "1", "AI": "1", "AL": "355", "AM": "374", "AO": "244",
+	"AR": "54", "AS": "1", "AT": "43", "AU": "61", "AW": "297", "AZ": "994", "BA": "387", "BB": "1",
+	"BD": "880", "BE": "32", "BF": "226", "BG": "359", "BH": "973", "BI": "257", "BJ": "229", "BM": "1",
+	"BN": "673", "BO": "591", "BR": "55", "BS": "1", "BT": "975", "BW": "267", "BY": "375", "BZ": "501",
+	"CA": "1", "CC": "61", "CD": "243", "CF": "236", "CH": "41", "CI": "225", "CK": "682", "CL": "56",
+	"CM": "237", "CN": "86", "CO": "57", "CR": "506", "CU": "53", "CV": "238", "CX": "61", "CY": "357",
+	"CZ": "420", "DE": "49", "DJ": "253", "DK": "45", "DM": "1", "DO": "1", "DZ": "213", "EC": "593",
+	"EE": "372", "EG": "20", "EH": "212", "ER": "291", "ES": "34", "ET": "251", "FI": "358", "FJ": "679",
+	"FK": "500", "FM": "691", "FO": "298", "FR": "33", "GA": "241", "GB": "44", "GD": "1", "GE": "995",
+	"GF": "594", "GH": "233", "GI": "350", "GL": "299", "GM": "220", "GN": "224", "GP": "590", "GQ": "240",
+	"GR": "30", "GS": "500", "GT": "502", "GU": "1", "GW": "245", "GY": "592", "HK": "852", "HN": "504",
+	"HR": "385", "HT": "509", "HU": "36", "ID": "62", "IE": "353", "IL": "972", "IN": "91", "IO": "246",
+	"IQ": "964", "IR": "98", "IS": "354", "IT": "39", "JM": "1", "JO": "962", "JP": "81", "KE": "254",
+	"KG": "996", "KH": "855", "KI": "686", "KM": "269", "KN": "1", "KP": "850", "KR": "82", "KW": "965",
+	"KY": "1", "KZ": "7", "LA": "856", "LB": "961", "LC": "1", "LI": "423", "LK": "94", "LR": "231",
+	"LS": "266", "LT": "370", "LU": "352", "LV": "371", "LY": "218", "MA": "212", "MC": "377", "MD": "373",
+	"MG": "261", "MH": "692", "MK": "389", "ML": "223", "MM": "95", "MN": "976", "MO": "853", "MP": "1",
+	"MQ": "596", "MR": "222", "MS": "1", "MT": "356", "MU": "230", "MV": "960", "MW": "265", "MX": "52",
+	"MY": "60", "MZ": "258", "NA": "264", "NC": "687", "NE": "227", "NF": "672", "NG": "234", "NI": "505",
+	"NL": "31", "NO": "47", "NP": "977", "NR": "674", "NU": "683", "NZ": "64", "OM": "968", "PA": "507",
+	"PE": "51", "PF": "689", "PG": "675", "PH": "63", "PK": "92", "PL": "48", "PM": "508", "PN": "64",
+	"PR": "1", "PT": "351", "PW": "680", "PY": "595", "QA": "974", "RE": "262", "RO": "40", "RU": "7",
+	"RW": "250", "SA": "966", "SB": "677", "SC": "248", "SD": "249", "SE": "46", "SG": "65", "SH": "290",
+	"SI": "386", "SJ": "47", "SK": "421", "SL": "232", "SM": "378", "SN": "221", "SO": "252", "SR": "597",
+	"ST": "239", "SV": "503", "SY": "963", "SZ": "268", "TC": "1", "TD": "235", "TG": "228", "TH": "66",
+	"TJ": "992", "TK": "690", "TM": "993", "TN": "216", "TO": "676", "TP": "670", "TR": "90", "TT": "1",
+	"TV": "688", "TW": "886", "TZ": "255", "UA": "380", "UG": "256", "US": "1", "UY": "598", "UZ": "998",
+	"VA": "39", "VC": "1", "VE": "58", "VG": "1", "VI": "1", "VN": "84", "VU": "678", "WF": "681",
+	"WS": "685", "YE": "967", "YT": "262", "ZA": "27", "ZM": "260", "ZW": "263",
+}
+
+// callingAreaCodes holds the leading digits of the national numbers of countries that share their
+// calling code with another country, keyed by alpha-2 country code. A phone number is only resolved
+// to such a country when its national number starts with one of these digits; countries sharing a
+// calling code without an entry here, such as the United States for +1, take the remaining numbers.
+// An empty list leaves a country without its own numbering range out of phone number resolution.
+var callingAreaCodes = map[string][]string{
+	// North American Numbering Plan (+1) area codes.
+	"AG": {"268"},
+	"AI": {"264"},
+	"AS": {"684"},
+	"BB": {"246"},
+	"BM": {"441"},
+	"BS": {"242"},
+	"CA": {
+		"204", "226", "236", "249", "250", "257", "263", "289", "306", "343", "354", "365", "367", "368",
+		"382", "387", "403", "416", "418", "428", "431", "437", "438", "450", "460", "468", "474", "506",
+		"514", "519", "548", "579", "581", "584", "587", "600", "604", "613", "639", "647", "672", "683",
+		"705", "709", "742", "753", "778", "780", "782", "807", "819", "825", "867", "873", "879", "902",
+		"905", "942",
+	},
+	"DM": {"767"},
+	"DO": {"809", "829", "849"},
+	"GD": {"473"},
+	"GU": {"671"},
+	"JM": {"658", "876"},
+	"KN": {"869"},
+	"KY": {"345"},
+	"LC": {"758"},
+	"MP": {"670"},
+	"MS": {"664"},
+	"PR": {"787", "939"},
+	"TC": {"649"},
+	"TT": {"868"},
+	"VC": {"784"},
+	"VG": {"284"},
+	"VI": {"340"},
+
+	// Other shared calling codes.
+	"CC": {"89162"},        // +61, Cocos (Keeling) Islands
+	"CX": {"89164"},        // +61, Christmas Island
+	"EH": {"5288", "5289"}, // +212, Western Sahara
+	"KZ": {"6", "7"},       // +7, Kazakhstan
+	"PN": {},               // +64, Pitcairn Islands, numbered within the New Zealand plan
+	"SJ": {"79"},           // +47, Svalbard
+	"VA": {"06698"},        // +39, Vatican City
+	"YT": {"269", "639"},   // +262, Mayotte
+}
+
+// callingPrefixes maps each calling code, followed by an area code where one applies, to the
+// countries using it.
+var callingPrefixes map[string][]string
+
+// maxCallingPrefixLength is the length of the longest key of callingPrefixes.
+var maxCallingPrefixLength int
+
+func init() {
+	callingPrefixes = make(map[string][]string)
+	for countryCode, callingCode := range callingCodes {
+		prefixes := []string{callingCode}
+		if areaCodes, ok := callingAreaCodes[countryCode]; ok {
+			prefixes = prefixes[:0]
+			for _, areaCode := range areaCodes {
+				prefixes = append(prefixes, callingCode+areaCode)
+			}
+		}
+		for _, prefix := range prefixes {
+			callingPrefixes[prefix] = append(callingPrefixes[prefix], countryCode)
+			maxCallingPrefixLength = max(maxCallingPrefixLength, len(prefix))
+		}
+	}
+	for _, countryCodes := range callingPrefixes {
+		sort.Strings(countryCodes)
+	}
+}
+
+// CountryGetCallingCode returns the E.164 international calling code of a country, such as "+33"
+// for France. It returns an empty string for countries without a calling code of their own.
+func (r *Registry) CountryGetCallingCode(countryCode string) (string, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return "", err
+	}
+	callingCode, ok := callingCodes[countryCode]
+	if !ok {
+		return "", nil
+	}
+	return "+" + callingCode, nil
+}
+
+// PhoneNumberGetCountries returns the countries an E.164 phone number such as "+33123456789" may
+// belong to, sorted by country code. It matches the longest known prefix of the number, using area
+// codes to tell apart the countries sharing a calling code, such as +1 or +7; several countries are
+// returned when the number cannot be told apart, as for the Falkland Islands and South Georgia on +500.
+func (r *Registry) PhoneNumberGetCountries(number string) ([]string, error) {
+	if !e164Regex.MatchString(number) {
+		return nil, &InvalidPhoneNumberError{Number: number}
+	}
+	digits := number[1:]
+	for n := min(len(digits), maxCallingPrefixLength); n > 0; n-- {
+		var countries []string
+		for _, countryCode := range callingPrefixes[digits[:n]] {
+			if _, ok := r.countryMap[countryCode]; ok {
+				countries = append(countries, countryCode)
+			}
+		}
+		if len(countries) > 0 {
+			return countries, nil
+		}
+	}
+	return nil, &PhoneNumberNotFoundError{Number: number}
+}
+
+// CountryGetCallingCode returns the E.164 international calling code of a country, such as "+33"
+// for France. It returns an empty string for countries without a calling code of their own.
+func CountryGetCallingCode(countryCode string) (string, error) {
+	return defaultRegistry.CountryGetCallingCode(countryCode)
+}
+
+// PhoneNumberGetCountries returns the countries an E.164 phone number such as "+33123456789" may
+// belong to, sorted by country code, using area codes to tell apart the countries sharing a calling code.
+func PhoneNumberGetCountries(number string) ([]string, error) {
+	return defaultRegistry.PhoneNumberGetCountries(number)
+}
@@ -0,0 +1,137 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryGetCallingCode(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: "+33"},
+		{name: "United States", code: "US", want: "+1"},
+		{name: "Jamaica shares +1", code: "JM", want: "+1"},
+		{name: "Kazakhstan shares +7", code: "KZ", want: "+7"},
+		{name: "Pitcairn shares +64", code: "PN", want: "+64"},
+		{name: "No calling code", code: "UM", want: ""},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetCallingCode(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetCallingCode(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("CountryGetCallingCode(%s) = %s; want %s", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPhoneNumberGetCountries(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        string
+		want          []string
+		expectedError error
+	}{
+		{name: "France", number: "+33123456789", want: []string{"FR"}},
+		{name: "United States", number: "+12125550123", want: []string{"US"}},
+		{name: "Canada", number: "+14165550123", want: []string{"CA"}},
+		{name: "Jamaica", number: "+18765550123", want: []string{"JM"}},
+		{name: "Puerto Rico", number: "+19395550123", want: []string{"PR"}},
+		{name: "Russia", number: "+74951234567", want: []string{"RU"}},
+		{name: "Kazakhstan", number: "+77011234567", want: []string{"KZ"}},
+		{name: "Mayotte", number: "+262269612345", want: []string{"YT"}},
+		{name: "Reunion", number: "+262262123456", want: []string{"RE"}},
+		{name: "Vatican City", number: "+390669812345", want: []string{"VA"}},
+		{name: "Italy", number: "+390612345678", want: []string{"IT"}},
+		{name: "Christmas Island", number: "+61891641234", want: []string{"CX"}},
+		{name: "New Zealand", number: "+6491234567", want: []string{"NZ"}},
+		{name: "Shared without area codes", number: "+50012345", want: []string{"FK", "GS"}},
+		{name: "Calling code only", number: "+44", want: []string{"GB"}},
+		{name: "Unassigned calling code", number: "+999123456", expectedError: &PhoneNumberNotFoundError{Number: "+999123456"}},
+		{name: "Missing plus", number: "33123456789", expectedError: &InvalidPhoneNumberError{Number: "33123456789"}},
+		{name: "Spaces", number: "+33 1 23 45 67 89", expectedError: &InvalidPhoneNumberError{Number: "+33 1 23 45 67 89"}},
+		{name: "Too long", number: "+1234567890123456", expectedError: &InvalidPhoneNumberError{Number: "+1234567890123456"}},
+		{name: "Leading zero", number: "+0123456", expectedError: &InvalidPhoneNumberError{Number: "+0123456"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := PhoneNumberGetCountries(tc.number)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("PhoneNumberGetCountries(%s) error = %v, wantError %v", tc.number, err, tc.expectedError)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("PhoneNumberGetCountries(%s) = %v; want %v", tc.number, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPhoneNumberGetCountriesRegistry(t *testing.T) {
+	r, err := NewRegistry([]CountryContinent{
+		{"US", "United States", "North America", "USA", "840"},
+		{"CA", "Canada", "North America", "CAN", "124"},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	if got, err := r.PhoneNumberGetCountries("+18765550123"); err != nil || !reflect.DeepEqual(got, []string{"US"}) {
+		t.Errorf("PhoneNumberGetCountries(+18765550123) = %v, %v; want [US]", got, err)
+	}
+	if _, err := r.PhoneNumberGetCountries("+33123456789"); !sameError(err, &PhoneNumberNotFoundError{Number: "+33123456789"}) {
+		t.Errorf("PhoneNumberGetCountries(+33123456789) error = %v; want PhoneNumberNotFoundError", err)
+	}
+}
+
+func TestCallingCodeTablesConsistent(t *testing.T) {
+	for countryCode := range callingCodes {
+		if _, ok := defaultRegistry.countryMap[countryCode]; !ok {
+			t.Errorf("calling code for unknown country %s", countryCode)
+		}
+	}
+	for countryCode := range callingAreaCodes {
+		if _, ok := callingCodes[countryCode]; !ok {
+			t.Errorf("area codes for %s, which has no calling code", countryCode)
+		}
+	}
+	for prefix, countryCodes := range callingPrefixes {
+		for _, countryCode := range countryCodes {
+			if _, ok := callingAreaCodes[countryCode]; ok && len(countryCodes) > 1 {
+				t.Errorf("area code prefix %s is shared by %v", prefix, countryCodes)
+			}
+		}
+	}
+	for _, c := range countryContinent {
+		callingCode, ok := callingCodes[c.CountryCode]
+		if !ok {
+			continue
+		}
+		number := "+" + callingCode + "0000000"
+		if areaCodes, ok := callingAreaCodes[c.CountryCode]; ok {
+			if len(areaCodes) == 0 {
+				continue // Left out of phone number resolution
+			}
+			number = "+" + callingCode + areaCodes[0] + "0000"
+		}
+		countries, err := PhoneNumberGetCountries(number)
+		if err != nil {
+			t.Errorf("PhoneNumberGetCountries(%s) error = %v", number, err)
+			continue
+		}
+		found := false
+		for _, countryCode := range countries {
+			found = found || countryCode == c.CountryCode
+		}
+		if !found {
+			t.Errorf("PhoneNumberGetCountries(%s) = %v; want it to include %s", number, countries, c.CountryCode)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Command countrycontinent-server serves country and continent lookups as a JSON HTTP API.
+//
+// Usage:
+//
+//	countrycontinent-server [-addr :8080] [-csv FILE | -json FILE]
+//
+// See package httpapi for the endpoints.
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/demoulin/countrycontinent/v1.5.1"
+	"github.com/demoulin/countrycontinent/v1.5.1/httpapi"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	csvFile := flag.String("csv", "", "serve the dataset in this CSV file instead of the embedded table")
+	jsonFile := flag.String("json", "", "serve the dataset in this JSON file instead of the embedded table")
+	flag.Parse()
+
+	registry := countrycontinent.DefaultRegistry()
+	var err error
+	switch {
+	case *csvFile != "" && *jsonFile != "":
+		log.Fatal("countrycontinent-server: -csv and -json are mutually exclusive")
+	case *csvFile != "":
+		registry, err = loadFile(*csvFile, countrycontinent.LoadCSV)
+	case *jsonFile != "":
+		registry, err = loadFile(*jsonFile, countrycontinent.LoadJSON)
+	}
+	if err != nil {
+		log.Fatalf("countrycontinent-server: %v", err)
+	}
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           httpapi.NewHandler(registry),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("countrycontinent-server: serving dataset %s on %s", registry.Version(), *addr)
+	log.Fatal(server.ListenAndServe())
+}
+
+// loadFile builds a registry from the named file with the given loader.
+func loadFile(name string, load func(io.Reader) (*countrycontinent.Registry, error)) (*countrycontinent.Registry, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return load(f)
+}
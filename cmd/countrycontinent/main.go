@@ -0,0 +1,246 @@
+// Command countrycontinent looks up countries and continents from the command line.
+//
+// Usage:
+//
+//	countrycontinent name [-lenient] [CODE...]
+//	countrycontinent continent [-lenient] [CODE...]
+//	countrycontinent countries CONTINENT
+//	countrycontinent search [-n LIMIT] NAME...
+//	countrycontinent dump [-format csv|json|tsv]
+//
+// The name and continent subcommands read country codes from their arguments, or from
+// standard input one per line when no arguments are given.
+//
+// Exit status is 0 on success, 1 on other errors, 2 on usage errors, 3 if a country code
+// is malformed and 4 if a country, continent or name is not found. When several codes
+// fail, the exit status is that of the first failure.
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/demoulin/countrycontinent/v1.5.1"
+)
+
+// Exit codes.
+const (
+	exitOK       = 0
+	exitError    = 1
+	exitUsage    = 2
+	exitInvalid  = 3
+	exitNotFound = 4
+)
+
+const usage = `usage:
+  countrycontinent name [-lenient] [CODE...]
+  countrycontinent continent [-lenient] [CODE...]
+  countrycontinent countries CONTINENT
+  countrycontinent search [-n LIMIT] NAME...
+  countrycontinent dump [-format csv|json|tsv]
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command with the given arguments and returns its exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitUsage
+	}
+	cmd := command{stdout: stdout, stderr: stderr}
+	switch args[0] {
+	case "name":
+		return cmd.lookup(args, stdin, func(c countrycontinent.CountryContinent) string { return c.CountryName })
+	case "continent":
+		return cmd.lookup(args, stdin, func(c countrycontinent.CountryContinent) string { return c.Continent })
+	case "countries":
+		return cmd.countries(args)
+	case "search":
+		return cmd.search(args)
+	case "dump":
+		return cmd.dump(args)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	}
+	fmt.Fprintf(stderr, "countrycontinent: unknown command %q\n%s", args[0], usage)
+	return exitUsage
+}
+
+// command holds the output streams of a command run.
+type command struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// newFlagSet returns a flag set for a subcommand that reports errors on stderr.
+func (c command) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stderr)
+	return fs
+}
+
+// fail prints err on stderr, with suggestions if it carries any, and returns the matching exit code.
+func (c command) fail(err error) int {
+	fmt.Fprintf(c.stderr, "countrycontinent: %v\n", err)
+	var suggester interface{ Suggestions() []string }
+	if errors.As(err, &suggester) {
+		if suggestions := suggester.Suggestions(); len(suggestions) > 0 {
+			fmt.Fprintf(c.stderr, "did you mean %s?\n", strings.Join(suggestions, ", "))
+		}
+	}
+	switch {
+	case errors.Is(err, countrycontinent.ErrInvalidCountryCode):
+		return exitInvalid
+	case errors.Is(err, countrycontinent.ErrCountryNotFound), errors.Is(err, countrycontinent.ErrContinentNotFound):
+		return exitNotFound
+	}
+	return exitError
+}
+
+// lookup prints a field of each country given as argument or on stdin.
+func (c command) lookup(args []string, stdin io.Reader, field func(countrycontinent.CountryContinent) string) int {
+	fs := c.newFlagSet(args[0])
+	lenient := fs.Bool("lenient", false, "normalize codes before the lookup (trim, upper-case, map UK to GB, ...)")
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+
+	status := exitOK
+	lookup := func(code string) {
+		var country countrycontinent.CountryContinent
+		var err error
+		if *lenient {
+			code, err = countrycontinent.Normalize(code)
+		}
+		if err == nil {
+			country, err = countrycontinent.CountryLookup(code)
+		}
+		if err != nil {
+			if exit := c.fail(err); status == exitOK {
+				status = exit
+			}
+			return
+		}
+		fmt.Fprintln(c.stdout, field(country))
+	}
+
+	if fs.NArg() > 0 {
+		for _, code := range fs.Args() {
+			lookup(code)
+		}
+		return status
+	}
+	// Codes on stdin are looked up as they are read, ignoring surrounding spaces and blank lines.
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if code := strings.TrimSpace(scanner.Text()); code != "" {
+			lookup(code)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if exit := c.fail(err); status == exitOK {
+			status = exit
+		}
+	}
+	return status
+}
+
+// countries prints the country codes of a continent.
+func (c command) countries(args []string) int {
+	fs := c.newFlagSet(args[0])
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprint(c.stderr, usage)
+		return exitUsage
+	}
+	continent, err := countrycontinent.ParseContinent(fs.Arg(0))
+	if err != nil {
+		return c.fail(err)
+	}
+	countries, err := countrycontinent.ContinentGetCountriesTyped(continent)
+	if err != nil {
+		return c.fail(err)
+	}
+	for _, code := range countries {
+		fmt.Fprintln(c.stdout, code)
+	}
+	return exitOK
+}
+
+// search prints the countries whose name resembles the given name.
+func (c command) search(args []string) int {
+	fs := c.newFlagSet(args[0])
+	limit := fs.Int("n", 5, "maximum number of matches")
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() == 0 {
+		fmt.Fprint(c.stderr, usage)
+		return exitUsage
+	}
+	name := strings.Join(fs.Args(), " ")
+	matches := countrycontinent.CountryCodeFromNameFuzzy(name, *limit)
+	if len(matches) == 0 {
+		return c.fail(&countrycontinent.CountryNameNotFoundError{Name: name})
+	}
+	for _, m := range matches {
+		fmt.Fprintf(c.stdout, "%s\t%s\t%.2f\n", m.CountryCode, m.CountryName, m.Score)
+	}
+	return exitOK
+}
+
+// dump prints the whole country table.
+func (c command) dump(args []string) int {
+	fs := c.newFlagSet(args[0])
+	format := fs.String("format", "csv", "output format: csv, json or tsv")
+	if err := fs.Parse(args[1:]); err != nil {
+		return exitUsage
+	}
+
+	r := countrycontinent.DefaultRegistry()
+	var err error
+	switch *format {
+	case "csv":
+		err = r.WriteCSV(c.stdout)
+	case "json":
+		err = r.WriteJSON(c.stdout)
+	case "tsv":
+		err = writeTSV(c.stdout, r.Countries())
+	default:
+		fmt.Fprintf(c.stderr, "countrycontinent: unknown format %q\n", *format)
+		return exitUsage
+	}
+	if err != nil {
+		return c.fail(err)
+	}
+	return exitOK
+}
+
+// writeTSV writes countries as tab-separated values with the same columns as Registry.WriteCSV.
+func writeTSV(w io.Writer, countries []countrycontinent.CountryContinent) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = '\t'
+	if err := writer.Write([]string{"country_code", "country_name", "continent", "alpha3", "numeric"}); err != nil {
+		return err
+	}
+	for _, country := range countries {
+		record := []string{country.CountryCode, country.CountryName, country.Continent, country.CountryCodeAlpha3, country.CountryCodeNumeric}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		wantOut    string
+		wantStatus int
+	}{
+		{name: "Name from args", args: []string{"name", "MQ", "FRA", "250"}, wantOut: "Martinique\nFrance\nFrance\n", wantStatus: exitOK},
+		{name: "Continent from args", args: []string{"continent", "MQ"}, wantOut: "Caribbean\n", wantStatus: exitOK},
+		{name: "Name from stdin", args: []string{"name"}, stdin: "DE\nJP\n", wantOut: "Germany\nJapan\n", wantStatus: exitOK},
+		{name: "Blank lines on stdin", args: []string{"name"}, stdin: "FR\n\n  DE \r\n\n", wantOut: "France\nGermany\n", wantStatus: exitOK},
+		{name: "Invalid code", args: []string{"name", "us"}, wantOut: "", wantStatus: exitInvalid},
+		{name: "Unknown code", args: []string{"continent", "XX"}, wantOut: "", wantStatus: exitNotFound},
+		{name: "First failure sets the status", args: []string{"name", "XX", "us", "FR"}, wantOut: "France\n", wantStatus: exitNotFound},
+		{name: "Lenient", args: []string{"name", "-lenient", " uk "}, wantOut: "United Kingdom\n", wantStatus: exitOK},
+		{name: "Countries", args: []string{"countries", "north america"}, wantOut: "CA\nGL\nMX\nPM\nUS\n", wantStatus: exitOK},
+		{name: "Unknown continent", args: []string{"countries", "Atlantis"}, wantOut: "", wantStatus: exitNotFound},
+		{name: "Countries without continent", args: []string{"countries"}, wantOut: "", wantStatus: exitUsage},
+		{name: "Search", args: []string{"search", "-n", "1", "Ivory", "Coast"}, wantOut: "CI\tCote D'Ivoire (Ivory Coast)\t1.00\n", wantStatus: exitOK},
+		{name: "Search without match", args: []string{"search", "Xyzzy"}, wantOut: "", wantStatus: exitNotFound},
+		{name: "Unknown format", args: []string{"dump", "-format", "xml"}, wantOut: "", wantStatus: exitUsage},
+		{name: "Unknown command", args: []string{"frobnicate"}, wantOut: "", wantStatus: exitUsage},
+		{name: "No command", args: nil, wantOut: "", wantStatus: exitUsage},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			status := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+			if status != tc.wantStatus {
+				t.Errorf("run(%v) = %d, want %d (stderr: %s)", tc.args, status, tc.wantStatus, stderr.String())
+			}
+			if stdout.String() != tc.wantOut {
+				t.Errorf("run(%v) output = %q, want %q", tc.args, stdout.String(), tc.wantOut)
+			}
+		})
+	}
+}
+
+// lineReader returns one line per Read and records the output written before each Read.
+type lineReader struct {
+	lines  []string
+	stdout *strings.Builder
+	seen   []string
+}
+
+func (r *lineReader) Read(p []byte) (int, error) {
+	r.seen = append(r.seen, r.stdout.String())
+	if len(r.lines) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.lines[0])
+	r.lines = r.lines[1:]
+	return n, nil
+}
+
+func TestRunStdinLineByLine(t *testing.T) {
+	var stdout, stderr strings.Builder
+	stdin := &lineReader{lines: []string{"FR\n", "DE\n"}, stdout: &stdout}
+	if status := run([]string{"name"}, stdin, &stdout, &stderr); status != exitOK {
+		t.Fatalf("run(name) = %d, want %d (stderr: %s)", status, exitOK, stderr.String())
+	}
+	if want := []string{"", "France\n", "France\nGermany\n"}; !reflect.DeepEqual(stdin.seen, want) {
+		t.Errorf("output before each read = %q, want %q", stdin.seen, want)
+	}
+}
+
+func TestRunDump(t *testing.T) {
+	tests := []struct {
+		format     string
+		wantPrefix string
+		wantLine   string
+	}{
+		{format: "csv", wantPrefix: "country_code,country_name,continent,alpha3,numeric\n", wantLine: "FR,France,Europe,FRA,250\n"},
+		{format: "tsv", wantPrefix: "country_code\tcountry_name\tcontinent\talpha3\tnumeric\n", wantLine: "FR\tFrance\tEurope\tFRA\t250\n"},
+		{format: "json", wantPrefix: "[\n", wantLine: `"country_name": "France",`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			if status := run([]string{"dump", "-format", tc.format}, nil, &stdout, &stderr); status != exitOK {
+				t.Fatalf("dump -format %s = %d, want %d (stderr: %s)", tc.format, status, exitOK, stderr.String())
+			}
+			if !strings.HasPrefix(stdout.String(), tc.wantPrefix) {
+				t.Errorf("dump -format %s does not start with %q", tc.format, tc.wantPrefix)
+			}
+			if !strings.Contains(stdout.String(), tc.wantLine) {
+				t.Errorf("dump -format %s does not contain %q", tc.format, tc.wantLine)
+			}
+		})
+	}
+}
+
+func TestRunSuggestions(t *testing.T) {
+	tests := []struct {
+		args       []string
+		wantStderr string
+	}{
+		{args: []string{"name", "UK"}, wantStderr: "did you mean GB, UA, CK, DK, FK?\n"},
+		{args: []string{"countries", "Oceana"}, wantStderr: "did you mean Oceania?\n"},
+	}
+	for _, tc := range tests {
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			if status := run(tc.args, nil, &stdout, &stderr); status != exitNotFound {
+				t.Errorf("run(%v) = %d, want %d", tc.args, status, exitNotFound)
+			}
+			if !strings.HasSuffix(stderr.String(), tc.wantStderr) {
+				t.Errorf("run(%v) stderr = %q, want it to end with %q", tc.args, stderr.String(), tc.wantStderr)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package countrycontinent
+
+import "regexp"
+
+var isoAlpha3CodeRegex = regexp.MustCompile("^[A-Z]{3}$")
+var isoNumericCodeRegex = regexp.MustCompile("^[0-9]{3}$")
+
+// isValidAlpha3Code checks if the country code is a 3-letter uppercase string.
+func isValidAlpha3Code(code string) bool {
+	return isoAlpha3CodeRegex.MatchString(code)
+}
+
+// isValidNumericCode checks if the country code is a 3-digit string.
+func isValidNumericCode(code string) bool {
+	return isoNumericCodeRegex.MatchString(code)
+}
+
+// lookupAlpha2 returns the country with the given alpha-2 country code.
+func (r *Registry) lookupAlpha2(countryCode string) (CountryContinent, error) {
+	if !isValidCountryCode(countryCode) {
+		return CountryContinent{}, &InvalidCountryCodeError{CountryCode: countryCode}
+	}
+	country, ok := r.countryMap[countryCode]
+	if !ok {
+		return CountryContinent{}, r.countryNotFound(countryCode)
+	}
+	return country, nil
+}
+
+// lookupAlpha3 returns the country with the given alpha-3 country code.
+func (r *Registry) lookupAlpha3(countryCode string) (CountryContinent, error) {
+	if !isValidAlpha3Code(countryCode) {
+		return CountryContinent{}, &InvalidCountryCodeError{CountryCode: countryCode}
+	}
+	alpha2, ok := r.alpha3Map[countryCode]
+	if !ok {
+		return CountryContinent{}, r.countryNotFound(countryCode)
+	}
+	return r.countryMap[alpha2], nil
+}
+
+// lookupNumeric returns the country with the given numeric country code.
+func (r *Registry) lookupNumeric(countryCode string) (CountryContinent, error) {
+	if !isValidNumericCode(countryCode) {
+		return CountryContinent{}, &InvalidCountryCodeError{CountryCode: countryCode}
+	}
+	alpha2, ok := r.numericMap[countryCode]
+	if !ok {
+		return CountryContinent{}, r.countryNotFound(countryCode)
+	}
+	return r.countryMap[alpha2], nil
+}
+
+// CountryLookup returns the country with the given country code, which may be
+// an ISO 3166-1 alpha-2 ("FR"), alpha-3 ("FRA") or numeric ("250") code.
+func (r *Registry) CountryLookup(countryCode string) (CountryContinent, error) {
+	switch {
+	case isValidCountryCode(countryCode):
+		return r.lookupAlpha2(countryCode)
+	case isValidAlpha3Code(countryCode):
+		return r.lookupAlpha3(countryCode)
+	case isValidNumericCode(countryCode):
+		return r.lookupNumeric(countryCode)
+	}
+	return CountryContinent{}, &InvalidCountryCodeError{CountryCode: countryCode}
+}
+
+// Alpha2ToAlpha3 converts an alpha-2 country code to its alpha-3 country code.
+func (r *Registry) Alpha2ToAlpha3(countryCode string) (string, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCodeAlpha3, nil
+}
+
+// Alpha2ToNumeric converts an alpha-2 country code to its numeric country code.
+func (r *Registry) Alpha2ToNumeric(countryCode string) (string, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCodeNumeric, nil
+}
+
+// Alpha3ToAlpha2 converts an alpha-3 country code to its alpha-2 country code.
+func (r *Registry) Alpha3ToAlpha2(countryCode string) (string, error) {
+	country, err := r.lookupAlpha3(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCode, nil
+}
+
+// Alpha3ToNumeric converts an alpha-3 country code to its numeric country code.
+func (r *Registry) Alpha3ToNumeric(countryCode string) (string, error) {
+	country, err := r.lookupAlpha3(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCodeNumeric, nil
+}
+
+// NumericToAlpha2 converts a numeric country code to its alpha-2 country code.
+func (r *Registry) NumericToAlpha2(countryCode string) (string, error) {
+	country, err := r.lookupNumeric(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCode, nil
+}
+
+// NumericToAlpha3 converts a numeric country code to its alpha-3 country code.
+func (r *Registry) NumericToAlpha3(countryCode string) (string, error) {
+	country, err := r.lookupNumeric(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCodeAlpha3, nil
+}
+
+// CountryLookup returns the country with the given country code, which may be
+// an ISO 3166-1 alpha-2 ("FR"), alpha-3 ("FRA") or numeric ("250") code.
+func CountryLookup(countryCode string) (CountryContinent, error) {
+	return defaultRegistry.CountryLookup(countryCode)
+}
+
+// Alpha2ToAlpha3 converts an alpha-2 country code to its alpha-3 country code.
+func Alpha2ToAlpha3(countryCode string) (string, error) {
+	return defaultRegistry.Alpha2ToAlpha3(countryCode)
+}
+
+// Alpha2ToNumeric converts an alpha-2 country code to its numeric country code.
+func Alpha2ToNumeric(countryCode string) (string, error) {
+	return defaultRegistry.Alpha2ToNumeric(countryCode)
+}
+
+// Alpha3ToAlpha2 converts an alpha-3 country code to its alpha-2 country code.
+func Alpha3ToAlpha2(countryCode string) (string, error) {
+	return defaultRegistry.Alpha3ToAlpha2(countryCode)
+}
+
+// Alpha3ToNumeric converts an alpha-3 country code to its numeric country code.
+func Alpha3ToNumeric(countryCode string) (string, error) {
+	return defaultRegistry.Alpha3ToNumeric(countryCode)
+}
+
+// NumericToAlpha2 converts a numeric country code to its alpha-2 country code.
+func NumericToAlpha2(countryCode string) (string, error) {
+	return defaultRegistry.NumericToAlpha2(countryCode)
+}
+
+// NumericToAlpha3 converts a numeric country code to its alpha-3 country code.
+func NumericToAlpha3(countryCode string) (string, error) {
+	return defaultRegistry.NumericToAlpha3(countryCode)
+}
@@ -0,0 +1,90 @@
+package countrycontinent
+
+import "testing"
+
+func TestCountryLookup(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		wantCode      string
+		expectedError error
+	}{
+		{name: "Alpha-2 code FR", code: "FR", wantCode: "FR", expectedError: nil},
+		{name: "Alpha-3 code FRA", code: "FRA", wantCode: "FR", expectedError: nil},
+		{name: "Numeric code 250", code: "250", wantCode: "FR", expectedError: nil},
+		{name: "Numeric code 020", code: "020", wantCode: "AD", expectedError: nil},
+		{name: "Alpha-3 code USA", code: "USA", wantCode: "US", expectedError: nil},
+		{name: "Current alpha-3 code TLS", code: "TLS", wantCode: "TP", expectedError: nil},
+		{name: "Unknown alpha-2 XX", code: "XX", wantCode: "", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Unknown alpha-3 XXX", code: "XXX", wantCode: "", expectedError: &CountryNotFoundError{CountryCode: "XXX"}},
+		{name: "Unknown numeric 999", code: "999", wantCode: "", expectedError: &CountryNotFoundError{CountryCode: "999"}},
+		{name: "Empty code", code: "", wantCode: "", expectedError: &InvalidCountryCodeError{CountryCode: ""}},
+		{name: "Lowercase alpha-3", code: "fra", wantCode: "", expectedError: &InvalidCountryCodeError{CountryCode: "fra"}},
+		{name: "Numeric too short", code: "25", wantCode: "", expectedError: &InvalidCountryCodeError{CountryCode: "25"}},
+		{name: "Mixed letters and digits", code: "F25", wantCode: "", expectedError: &InvalidCountryCodeError{CountryCode: "F25"}},
+		{name: "Too long", code: "FRAN", wantCode: "", expectedError: &InvalidCountryCodeError{CountryCode: "FRAN"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryLookup(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryLookup(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err == nil && got.CountryCode != tc.wantCode {
+				t.Errorf("CountryLookup(%s) = %s; want %s", tc.code, got.CountryCode, tc.wantCode)
+			}
+		})
+	}
+}
+
+func TestCodeConversions(t *testing.T) {
+	tests := []struct {
+		name          string
+		convert       func(string) (string, error)
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "Alpha2ToAlpha3 FR", convert: Alpha2ToAlpha3, code: "FR", want: "FRA"},
+		{name: "Alpha2ToNumeric FR", convert: Alpha2ToNumeric, code: "FR", want: "250"},
+		{name: "Alpha3ToAlpha2 DEU", convert: Alpha3ToAlpha2, code: "DEU", want: "DE"},
+		{name: "Alpha3ToAlpha2 TLS", convert: Alpha3ToAlpha2, code: "TLS", want: "TP"},
+		{name: "Alpha3ToNumeric DEU", convert: Alpha3ToNumeric, code: "DEU", want: "276"},
+		{name: "NumericToAlpha2 840", convert: NumericToAlpha2, code: "840", want: "US"},
+		{name: "NumericToAlpha3 840", convert: NumericToAlpha3, code: "840", want: "USA"},
+		{name: "Alpha2ToAlpha3 rejects alpha-3", convert: Alpha2ToAlpha3, code: "FRA", expectedError: &InvalidCountryCodeError{CountryCode: "FRA"}},
+		{name: "Alpha3ToAlpha2 rejects alpha-2", convert: Alpha3ToAlpha2, code: "FR", expectedError: &InvalidCountryCodeError{CountryCode: "FR"}},
+		{name: "NumericToAlpha2 rejects alpha-3", convert: NumericToAlpha2, code: "FRA", expectedError: &InvalidCountryCodeError{CountryCode: "FRA"}},
+		{name: "Alpha2ToNumeric unknown", convert: Alpha2ToNumeric, code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Alpha3ToNumeric unknown", convert: Alpha3ToNumeric, code: "XXX", expectedError: &CountryNotFoundError{CountryCode: "XXX"}},
+		{name: "NumericToAlpha3 unknown", convert: NumericToAlpha3, code: "000", expectedError: &CountryNotFoundError{CountryCode: "000"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.convert(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("convert(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("convert(%s) = %s; want %s", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCodeTablesConsistent(t *testing.T) {
+	for _, c := range countryContinent {
+		if !isValidAlpha3Code(c.CountryCodeAlpha3) {
+			t.Errorf("%s: invalid alpha-3 code %q", c.CountryCode, c.CountryCodeAlpha3)
+		}
+		if !isValidNumericCode(c.CountryCodeNumeric) {
+			t.Errorf("%s: invalid numeric code %q", c.CountryCode, c.CountryCodeNumeric)
+		}
+	}
+	if len(defaultRegistry.alpha3Map) != len(countryContinent) {
+		t.Errorf("alpha-3 codes are not unique: %d codes for %d countries", len(defaultRegistry.alpha3Map), len(countryContinent))
+	}
+	if len(defaultRegistry.numericMap) != len(countryContinent) {
+		t.Errorf("numeric codes are not unique: %d codes for %d countries", len(defaultRegistry.numericMap), len(countryContinent))
+	}
+}
@@ -0,0 +1,124 @@
+package countrycontinent
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Continent identifies one of the continents or regions used in the country table.
+type Continent int
+
+// Continents and regions used in the country table. The zero value is not a valid continent.
+const (
+	ContinentAfrica Continent = iota + 1
+	ContinentAntarctica
+	ContinentAsia
+	ContinentCaribbean
+	ContinentCentralAmerica
+	ContinentEurope
+	ContinentNorthAmerica
+	ContinentOceania
+	ContinentSouthAmerica
+)
+
+// continentNames holds the name of each continent, as used in the country table.
+var continentNames = [...]string{
+	ContinentAfrica:         "Africa",
+	ContinentAntarctica:     "Antarctica",
+	ContinentAsia:           "Asia",
+	ContinentCaribbean:      "Caribbean",
+	ContinentCentralAmerica: "Central America",
+	ContinentEurope:         "Europe",
+	ContinentNorthAmerica:   "North America",
+	ContinentOceania:        "Oceania",
+	ContinentSouthAmerica:   "South America",
+}
+
+// continentCodes holds the standard short code of each of the seven continents. Central America
+// and the Caribbean have none.
+var continentCodes = [...]string{
+	ContinentAfrica:         "AF",
+	ContinentAntarctica:     "AN",
+	ContinentAsia:           "AS",
+	ContinentCaribbean:      "",
+	ContinentCentralAmerica: "",
+	ContinentEurope:         "EU",
+	ContinentNorthAmerica:   "NA",
+	ContinentOceania:        "OC",
+	ContinentSouthAmerica:   "SA",
+}
+
+// isValid reports whether c is one of the declared continents.
+func (c Continent) isValid() bool {
+	return c >= ContinentAfrica && c <= ContinentSouthAmerica
+}
+
+// String returns the name of the continent, as used in the country table.
+func (c Continent) String() string {
+	if !c.isValid() {
+		return "Continent(" + strconv.Itoa(int(c)) + ")"
+	}
+	return continentNames[c]
+}
+
+// Code returns the short code of the continent, such as "EU" or "NA", or an empty string for
+// Central America and the Caribbean, which have none.
+func (c Continent) Code() string {
+	if !c.isValid() {
+		return ""
+	}
+	return continentCodes[c]
+}
+
+// AllContinents returns all continents, sorted by name.
+func AllContinents() []Continent {
+	continents := make([]Continent, 0, len(continentNames)-1)
+	for c := ContinentAfrica; c <= ContinentSouthAmerica; c++ {
+		continents = append(continents, c)
+	}
+	return continents
+}
+
+// ParseContinent returns the continent with the given name or short code, ignoring case
+// and surrounding whitespace. Central America and the Caribbean parse by name only.
+func ParseContinent(s string) (Continent, error) {
+	name := strings.TrimSpace(s)
+	for _, c := range AllContinents() {
+		code := continentCodes[c]
+		if strings.EqualFold(name, continentNames[c]) || (code != "" && strings.EqualFold(name, code)) {
+			return c, nil
+		}
+	}
+	names := make([]string, 0, len(continentNames)-1)
+	for _, c := range AllContinents() {
+		names = append(names, c.String())
+	}
+	return 0, continentNotFound(s, names)
+}
+
+// CountryGetContinentTyped returns the continent of a country from its country code.
+func (r *Registry) CountryGetContinentTyped(countryCode string) (Continent, error) {
+	continent, err := r.CountryGetContinent(countryCode)
+	if err != nil {
+		return 0, err
+	}
+	return ParseContinent(continent)
+}
+
+// ContinentGetCountriesTyped returns a list of countries in a continent, sorted by country code.
+func (r *Registry) ContinentGetCountriesTyped(continent Continent) ([]string, error) {
+	if !continent.isValid() {
+		return nil, &ContinentNotFoundError{Continent: continent.String()}
+	}
+	return r.ContinentGetCountries(continent.String())
+}
+
+// CountryGetContinentTyped returns the continent of a country from its country code.
+func CountryGetContinentTyped(countryCode string) (Continent, error) {
+	return defaultRegistry.CountryGetContinentTyped(countryCode)
+}
+
+// ContinentGetCountriesTyped returns a list of countries in a continent, sorted by country code.
+func ContinentGetCountriesTyped(continent Continent) ([]string, error) {
+	return defaultRegistry.ContinentGetCountriesTyped(continent)
+}
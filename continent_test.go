@@ -0,0 +1,117 @@
+package countrycontinent
+
+import "testing"
+
+func TestParseContinent(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		want          Continent
+		expectedError error
+	}{
+		{name: "Exact name", input: "Europe", want: ContinentEurope, expectedError: nil},
+		{name: "Lowercase name", input: "north america", want: ContinentNorthAmerica, expectedError: nil},
+		{name: "Uppercase name", input: "OCEANIA", want: ContinentOceania, expectedError: nil},
+		{name: "Surrounding spaces", input: " Asia ", want: ContinentAsia, expectedError: nil},
+		{name: "Short code EU", input: "EU", want: ContinentEurope, expectedError: nil},
+		{name: "Short code na", input: "na", want: ContinentNorthAmerica, expectedError: nil},
+		{name: "Short code OC", input: "OC", want: ContinentOceania, expectedError: nil},
+		{name: "Caribbean by name", input: "caribbean", want: ContinentCaribbean, expectedError: nil},
+		{name: "No code CB", input: "CB", want: 0, expectedError: &ContinentNotFoundError{Continent: "CB"}},
+		{name: "Country code CA", input: "CA", want: 0, expectedError: &ContinentNotFoundError{Continent: "CA"}},
+		{name: "Typo", input: "Eurpoe", want: 0, expectedError: &ContinentNotFoundError{Continent: "Eurpoe"}},
+		{name: "Empty", input: "", want: 0, expectedError: &ContinentNotFoundError{Continent: ""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseContinent(tc.input)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("ParseContinent(%q) error = %v, wantError %v", tc.input, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("ParseContinent(%q) = %v; want %v", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContinentString(t *testing.T) {
+	tests := []struct {
+		continent Continent
+		wantName  string
+		wantCode  string
+	}{
+		{continent: ContinentAfrica, wantName: "Africa", wantCode: "AF"},
+		{continent: ContinentCentralAmerica, wantName: "Central America", wantCode: ""},
+		{continent: ContinentSouthAmerica, wantName: "South America", wantCode: "SA"},
+		{continent: 0, wantName: "Continent(0)", wantCode: ""},
+		{continent: 42, wantName: "Continent(42)", wantCode: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.wantName, func(t *testing.T) {
+			if got := tc.continent.String(); got != tc.wantName {
+				t.Errorf("String() = %q, want %q", got, tc.wantName)
+			}
+			if got := tc.continent.Code(); got != tc.wantCode {
+				t.Errorf("Code() = %q, want %q", got, tc.wantCode)
+			}
+		})
+	}
+}
+
+func TestAllContinentsCoverTable(t *testing.T) {
+	all := AllContinents()
+	if len(all) != len(defaultRegistry.continentMap) {
+		t.Errorf("AllContinents() returned %d continents, table has %d", len(all), len(defaultRegistry.continentMap))
+	}
+	for _, c := range all {
+		if _, ok := defaultRegistry.continentMap[c.String()]; !ok {
+			t.Errorf("continent %s has no countries in the table", c)
+		}
+	}
+}
+
+func TestCountryGetContinentTyped(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          Continent
+		expectedError error
+	}{
+		{name: "Valid code NL", code: "NL", want: ContinentEurope, expectedError: nil},
+		{name: "Valid code DM", code: "DM", want: ContinentCaribbean, expectedError: nil},
+		{name: "Valid code TF", code: "TF", want: ContinentAntarctica, expectedError: nil},
+		{name: "Unknown code XX", code: "XX", want: 0, expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Lowercase code fr", code: "fr", want: 0, expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetContinentTyped(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetContinentTyped(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("CountryGetContinentTyped(%s) = %v; want %v", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContinentGetCountriesTyped(t *testing.T) {
+	for _, c := range AllContinents() {
+		t.Run(c.String(), func(t *testing.T) {
+			got, err := ContinentGetCountriesTyped(c)
+			if err != nil {
+				t.Fatalf("ContinentGetCountriesTyped(%v) error = %v", c, err)
+			}
+			want, _ := getCountriesByContinent(c.String())
+			if !equalSlices(got, want) {
+				t.Errorf("ContinentGetCountriesTyped(%v) = %v; want %v", c, got, want)
+			}
+		})
+	}
+	_, err := ContinentGetCountriesTyped(0)
+	if !sameError(err, &ContinentNotFoundError{Continent: "Continent(0)"}) {
+		t.Errorf("ContinentGetCountriesTyped(0) error = %v, want ContinentNotFoundError", err)
+	}
+}
@@ -0,0 +1,115 @@
+package countrycontinent
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Country is a country of the embedded country table, identified by its alpha-2 country code.
+// It can be used directly in JSON payloads and database columns. The zero value is no country;
+// it is encoded as JSON null and SQL NULL.
+type Country struct {
+	code string
+}
+
+// ParseCountry returns the country with the given alpha-2 country code.
+func ParseCountry(countryCode string) (Country, error) {
+	if _, err := defaultRegistry.lookupAlpha2(countryCode); err != nil {
+		return Country{}, err
+	}
+	return Country{code: countryCode}, nil
+}
+
+// Code returns the alpha-2 country code of the country.
+func (c Country) Code() string {
+	return c.code
+}
+
+// String returns the alpha-2 country code of the country.
+func (c Country) String() string {
+	return c.code
+}
+
+// IsZero reports whether c is the zero value.
+func (c Country) IsZero() bool {
+	return c.code == ""
+}
+
+// Name returns the full name of the country, or an empty string for the zero value.
+func (c Country) Name() string {
+	return defaultRegistry.countryMap[c.code].CountryName
+}
+
+// Continent returns the continent of the country, or an empty string for the zero value.
+func (c Country) Continent() string {
+	return defaultRegistry.countryMap[c.code].Continent
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Country) MarshalText() ([]byte, error) {
+	return []byte(c.code), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text unmarshals to the zero Country,
+// which MarshalText encodes as empty text.
+func (c *Country) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = Country{}
+		return nil
+	}
+	return c.parse(string(text))
+}
+
+// parse sets c to the country with the given alpha-2 code.
+func (c *Country) parse(code string) error {
+	country, err := ParseCountry(code)
+	if err != nil {
+		return err
+	}
+	*c = country
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c Country) MarshalJSON() ([]byte, error) {
+	if c.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(c.code)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Country) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = Country{}
+		return nil
+	}
+	var code string
+	if err := json.Unmarshal(data, &code); err != nil {
+		return err
+	}
+	return c.parse(code)
+}
+
+// Scan implements sql.Scanner.
+func (c *Country) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*c = Country{}
+		return nil
+	case string:
+		return c.parse(v)
+	case []byte:
+		return c.parse(string(v))
+	}
+	return fmt.Errorf("cannot scan %T into Country", src)
+}
+
+// Value implements driver.Valuer.
+func (c Country) Value() (driver.Value, error) {
+	if c.IsZero() {
+		return nil, nil
+	}
+	return c.code, nil
+}
@@ -0,0 +1,152 @@
+package countrycontinent
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+func TestParseCountry(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		wantName      string
+		wantContinent string
+		expectedError error
+	}{
+		{name: "Valid code FR", code: "FR", wantName: "France", wantContinent: "Europe", expectedError: nil},
+		{name: "Valid code MQ", code: "MQ", wantName: "Martinique", wantContinent: "Caribbean", expectedError: nil},
+		{name: "Unknown code XX", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Lowercase code fr", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+		{name: "Empty code", code: "", expectedError: &InvalidCountryCodeError{CountryCode: ""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseCountry(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("ParseCountry(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err != nil {
+				if !got.IsZero() {
+					t.Errorf("ParseCountry(%s) = %v; want the zero value", tc.code, got)
+				}
+				return
+			}
+			if got.Code() != tc.code || got.Name() != tc.wantName || got.Continent() != tc.wantContinent {
+				t.Errorf("ParseCountry(%s) = %s, %s, %s; want %s, %s, %s", tc.code, got.Code(), got.Name(), got.Continent(), tc.code, tc.wantName, tc.wantContinent)
+			}
+		})
+	}
+}
+
+func TestCountryJSON(t *testing.T) {
+	type payload struct {
+		Country Country `json:"country"`
+	}
+	fr, _ := ParseCountry("FR")
+
+	data, err := json.Marshal(payload{Country: fr})
+	if err != nil || string(data) != `{"country":"FR"}` {
+		t.Errorf("json.Marshal() = %s, %v; want {\"country\":\"FR\"}", data, err)
+	}
+	data, err = json.Marshal(payload{})
+	if err != nil || string(data) != `{"country":null}` {
+		t.Errorf("json.Marshal(zero) = %s, %v; want {\"country\":null}", data, err)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    Country
+		wantErr bool
+	}{
+		{name: "Valid code", input: `{"country":"FR"}`, want: fr},
+		{name: "Null", input: `{"country":null}`, want: Country{}},
+		{name: "Unknown code", input: `{"country":"XX"}`, wantErr: true},
+		{name: "Invalid code", input: `{"country":"fr"}`, wantErr: true},
+		{name: "Empty code", input: `{"country":""}`, wantErr: true},
+		{name: "Not a string", input: `{"country":250}`, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got payload
+			err := json.Unmarshal([]byte(tc.input), &got)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("json.Unmarshal(%s) error = %v, wantErr %v", tc.input, err, tc.wantErr)
+			}
+			if err == nil && got.Country != tc.want {
+				t.Errorf("json.Unmarshal(%s) = %v; want %v", tc.input, got.Country, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryText(t *testing.T) {
+	var c Country
+	if err := c.UnmarshalText([]byte("JP")); err != nil || c.Name() != "Japan" {
+		t.Errorf("UnmarshalText(JP) = %v, %v; want Japan", c, err)
+	}
+	if text, _ := c.MarshalText(); string(text) != "JP" {
+		t.Errorf("MarshalText() = %s; want JP", text)
+	}
+	if err := c.UnmarshalText([]byte("jp")); err == nil {
+		t.Errorf("UnmarshalText(jp) error = nil, want an error")
+	}
+	if c.Code() != "JP" {
+		t.Errorf("failed UnmarshalText changed the country to %v", c)
+	}
+
+	var zero Country
+	text, err := zero.MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Errorf("MarshalText(zero) = %q, %v; want empty text", text, err)
+	}
+	if err := c.UnmarshalText(text); err != nil || !c.IsZero() {
+		t.Errorf("UnmarshalText(%q) = %v, %v; want the zero Country", text, c, err)
+	}
+
+	// Text round trips also cover map keys.
+	data, err := json.Marshal(map[Country]int{{code: "FR"}: 1})
+	if err != nil {
+		t.Fatalf("json.Marshal(map) error = %v", err)
+	}
+	var m map[Country]int
+	if err := json.Unmarshal(data, &m); err != nil || m[Country{code: "FR"}] != 1 {
+		t.Errorf("json.Unmarshal(%s) = %v, %v; want map[FR:1]", data, m, err)
+	}
+}
+
+func TestCountrySQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    Country
+		wantErr bool
+	}{
+		{name: "String", src: "DE", want: Country{code: "DE"}},
+		{name: "Bytes", src: []byte("DE"), want: Country{code: "DE"}},
+		{name: "NULL", src: nil, want: Country{}},
+		{name: "Unknown code", src: "XX", wantErr: true},
+		{name: "Unsupported type", src: int64(276), wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got Country
+			err := got.Scan(tc.src)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tc.src, err, tc.wantErr)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("Scan(%v) = %v; want %v", tc.src, got, tc.want)
+			}
+		})
+	}
+
+	de, _ := ParseCountry("DE")
+	if v, err := de.Value(); err != nil || v != driver.Value("DE") {
+		t.Errorf("Value() = %v, %v; want DE", v, err)
+	}
+	if v, err := (Country{}).Value(); err != nil || v != nil {
+		t.Errorf("zero Value() = %v, %v; want nil", v, err)
+	}
+}
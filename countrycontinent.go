@@ -14,9 +14,19 @@
 //
 //   - ContinentGetCountries(continent string) ([]string, error)
 //     Returns a list of country codes belonging to a given continent.
+//
+//   - CountryLookup(countryCode string) (CountryContinent, error)
+//     Returns the country record for an alpha-2, alpha-3 or numeric country code.
+//
+//   - CountryCodeFromName(name string) (string, error)
+//     Returns the country code of a country given its name or one of its aliases.
+//
+// The package-level functions use the embedded country table. Use NewRegistry to look up
+// countries in another dataset; a Registry exposes the same lookups as methods.
 package countrycontinent
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 )
@@ -25,30 +35,74 @@ var isoCountryCodeRegex = regexp.MustCompile("^[A-Z]{2}$")
 
 // CountryContinent is a struct that holds the country code, country name and continent
 type CountryContinent struct {
-	CountryCode string // ISO 3166-1 alpha-2 country code
-	CountryName string // Full name of the country
-	Continent   string // Continent to which the country belongs
+	CountryCode        string // ISO 3166-1 alpha-2 country code
+	CountryName        string // Full name of the country
+	Continent          string // Continent to which the country belongs
+	CountryCodeAlpha3  string // ISO 3166-1 alpha-3 country code
+	CountryCodeNumeric string // ISO 3166-1 numeric country code
 }
 
+// Sentinel errors matching the error types of the package with errors.Is.
+var (
+	ErrCountryNotFound    = errors.New("country not found")
+	ErrContinentNotFound  = errors.New("continent not found")
+	ErrInvalidCountryCode = errors.New("invalid country code format")
+)
+
 // CountryNotFoundError is returned when a country code is not found.
+// It matches ErrCountryNotFound.
 type CountryNotFoundError struct {
 	CountryCode string
+
+	suggest func() []string
 }
 
 func (e *CountryNotFoundError) Error() string {
 	return fmt.Sprintf("country code not found: %s", e.CountryCode)
 }
 
+// Is reports whether target is ErrCountryNotFound.
+func (e *CountryNotFoundError) Is(target error) bool {
+	return target == ErrCountryNotFound
+}
+
+// Suggestions returns the country codes the caller most likely meant, best first:
+// the ISO code for a well-known non-ISO code such as "UK", codes of countries whose
+// name starts with the code, and codes differing by a single character.
+func (e *CountryNotFoundError) Suggestions() []string {
+	if e.suggest == nil {
+		return nil
+	}
+	return e.suggest()
+}
+
 // ContinentNotFoundError is returned when a continent is not found.
+// It matches ErrContinentNotFound.
 type ContinentNotFoundError struct {
 	Continent string
+
+	suggest func() []string
 }
 
 func (e *ContinentNotFoundError) Error() string {
 	return fmt.Sprintf("continent not found: %s", e.Continent)
 }
 
+// Is reports whether target is ErrContinentNotFound.
+func (e *ContinentNotFoundError) Is(target error) bool {
+	return target == ErrContinentNotFound
+}
+
+// Suggestions returns the continents the caller most likely meant, closest first.
+func (e *ContinentNotFoundError) Suggestions() []string {
+	if e.suggest == nil {
+		return nil
+	}
+	return e.suggest()
+}
+
 // InvalidCountryCodeError is returned when a country code is not in the valid format.
+// It matches ErrInvalidCountryCode.
 type InvalidCountryCodeError struct {
 	CountryCode string
 }
@@ -57,253 +111,245 @@ func (e *InvalidCountryCodeError) Error() string {
 	return fmt.Sprintf("invalid country code format: %s", e.CountryCode)
 }
 
-// countryContinent is a slice of CountryContinent
-var countryContinent = []CountryContinent{
-	{"AD", "Andorra", "Europe"},
-	{"AE", "United Arab Emirates", "Asia"},
-	{"AF", "Afghanistan", "Asia"},
-	{"AG", "Antigua and Barbuda", "Caribbean"},
-	{"AI", "Anguilla", "Caribbean"},
-	{"AL", "Albania", "Europe"},
-	{"AM", "Armenia", "Asia"},
-	{"AO", "Angola", "Africa"},
-	{"AR", "Argentina", "South America"},
-	{"AS", "American Samoa", "Oceania"},
-	{"AT", "Austria", "Europe"},
-	{"AU", "Australia", "Oceania"},
-	{"AW", "Aruba", "Caribbean"},
-	{"AZ", "Azerbaijan", "Asia"},
-	{"BA", "Bosnia and Herzegovina", "Europe"},
-	{"BB", "Barbados", "Caribbean"},
-	{"BD", "Bangladesh", "Asia"},
-	{"BE", "Belgium", "Europe"},
-	{"BF", "Burkina Faso", "Africa"},
-	{"BG", "Bulgaria", "Europe"},
-	{"BH", "Bahrain", "Asia"},
-	{"BI", "Burundi", "Africa"},
-	{"BJ", "Benin", "Africa"},
-	{"BM", "Bermuda", "Caribbean"},
-	{"BN", "Brunei Darussalam", "Asia"},
-	{"BO", "Bolivia", "South America"},
-	{"BR", "Brazil", "South America"},
-	{"BS", "Bahamas", "Caribbean"},
-	{"BT", "Bhutan", "Asia"},
-	{"BW", "Botswana", "Africa"},
-	{"BY", "Belarus", "Europe"},
-	{"BZ", "Belize", "Central America"},
-	{"CA", "Canada", "North America"},
-	{"CC", "Cocos (Keeling) Islands", "Asia"},
-	{"CF", "Central African Republic", "Africa"},
-	{"CD", "Congo", "Africa"},
-	{"CH", "Switzerland", "Europe"},
-	{"CI", "Cote D'Ivoire (Ivory Coast)", "Africa"},
-	{"CK", "Cook Islands", "Oceania"},
-	{"CL", "Chile", "South America"},
-	{"CM", "Cameroon", "Africa"},
-	{"CN", "China", "Asia"},
-	{"CO", "Colombia", "South America"},
-	{"CR", "Costa Rica", "Central America"},
-	{"CU", "Cuba", "Caribbean"},
-	{"CV", "Cape Verde", "Africa"},
-	{"CX", "Christmas Island", "Asia"},
-	{"CY", "Cyprus", "Asia"},
-	{"CZ", "Czech Republic", "Europe"},
-	{"DE", "Germany", "Europe"},
-	{"DJ", "Djibouti", "Africa"},
-	{"DK", "Denmark", "Europe"},
-	{"DM", "Dominica", "Caribbean"},
-	{"DO", "Dominican Republic", "Caribbean"},
-	{"DZ", "Algeria", "Africa"},
-	{"EC", "Ecuador", "South America"},
-	{"EE", "Estonia", "Europe"},
-	{"EG", "Egypt", "Africa"},
-	{"EH", "Western Sahara", "Africa"},
-	{"ER", "Eritrea", "Africa"},
-	{"ES", "Spain", "Europe"},
-	{"ET", "Ethiopia", "Africa"},
-	{"FI", "Finland", "Europe"},
-	{"FJ", "Fiji", "Oceania"},
-	{"FK", "Falkland Islands (Malvinas)", "South America"},
-	{"FM", "Micronesia", "Oceania"},
-	{"FO", "Faroe Islands", "Europe"},
-	{"FR", "France", "Europe"},
-	{"GA", "Gabon", "Africa"},
-	{"GB", "United Kingdom", "Europe"},
-	{"GD", "Grenada", "Caribbean"},
-	{"GE", "Georgia", "Asia"},
-	{"GF", "French Guiana", "South America"},
-	{"GH", "Ghana", "Africa"},
-	{"GI", "Gibraltar", "Europe"},
-	{"GL", "Greenland", "North America"},
-	{"GM", "Gambia", "Africa"},
-	{"GN", "Guinea", "Africa"},
-	{"GP", "Guadeloupe", "Caribbean"},
-	{"GQ", "Equatorial Guinea", "Africa"},
-	{"GR", "Greece", "Europe"},
-	{"GS", "S. Georgia and S. Sandwich Isls.", "South America"},
-	{"GT", "Guatemala", "Central America"},
-	{"GU", "Guam", "Oceania"},
-	{"GW", "Guinea-Bissau", "Africa"},
-	{"GY", "Guyana", "South America"},
-	{"HK", "Hong Kong", "Asia"},
-	{"HN", "Honduras", "Central America"},
-	{"HR", "Croatia (Hrvatska)", "Europe"},
-	{"HT", "Haiti", "Caribbean"},
-	{"HU", "Hungary", "Europe"},
-	{"ID", "Indonesia", "Asia"},
-	{"IE", "Ireland", "Europe"},
-	{"IL", "Israel", "Asia"},
-	{"IN", "India", "Asia"},
-	{"IO", "British Indian Ocean Territory", "Asia"},
-	{"IQ", "Iraq", "Asia"},
-	{"IR", "Iran", "Asia"},
-	{"IS", "Iceland", "Europe"},
-	{"IT", "Italy", "Europe"},
-	{"JM", "Jamaica", "Caribbean"},
-	{"JO", "Jordan", "Asia"},
-	{"JP", "Japan", "Asia"},
-	{"KE", "Kenya", "Africa"},
-	{"KG", "Kyrgyzstan", "Asia"},
-	{"KH", "Cambodia", "Asia"},
-	{"KI", "Kiribati", "Oceania"},
-	{"KM", "Comoros", "Africa"},
-	{"KN", "Saint Kitts and Nevis", "Caribbean"},
-	{"KP", "Korea (North)", "Asia"},
-	{"KR", "Korea (South)", "Asia"},
-	{"KW", "Kuwait", "Asia"},
-	{"KY", "Cayman Islands", "Caribbean"},
-	{"KZ", "Kazakhstan", "Asia"},
-	{"LA", "Laos", "Asia"},
-	{"LB", "Lebanon", "Asia"},
-	{"LC", "Saint Lucia", "Caribbean"},
-	{"LI", "Liechtenstein", "Europe"},
-	{"LK", "Sri Lanka", "Asia"},
-	{"LR", "Liberia", "Africa"},
-	{"LS", "Lesotho", "Africa"},
-	{"LT", "Lithuania", "Europe"},
-	{"LU", "Luxembourg", "Europe"},
-	{"LV", "Latvia", "Europe"},
-	{"LY", "Libya", "Africa"},
-	{"MA", "Morocco", "Africa"},
-	{"MC", "Monaco", "Europe"},
-	{"MD", "Moldova", "Europe"},
-	{"MG", "Madagascar", "Africa"},
-	{"MH", "Marshall Islands", "Oceania"},
-	{"MK", "Macedonia", "Europe"},
-	{"ML", "Mali", "Africa"},
-	{"MM", "Myanmar", "Asia"},
-	{"MN", "Mongolia", "Asia"},
-	{"MO", "Macau", "Asia"},
-	{"MP", "Northern Mariana Islands", "Oceania"},
-	{"MQ", "Martinique", "Caribbean"},
-	{"MR", "Mauritania", "Africa"},
-	{"MS", "Montserrat", "Caribbean"},
-	{"MT", "Malta", "Europe"},
-	{"MU", "Mauritius", "Africa"},
-	{"MV", "Maldives", "Asia"},
-	{"MW", "Malawi", "Africa"},
-	{"MX", "Mexico", "North America"},
-	{"MY", "Malaysia", "Asia"},
-	{"MZ", "Mozambique", "Africa"},
-	{"NA", "Namibia", "Africa"},
-	{"NC", "New Caledonia", "Oceania"},
-	{"NE", "Niger", "Africa"},
-	{"NF", "Norfolk Island", "Oceania"},
-	{"NG", "Nigeria", "Africa"},
-	{"NI", "Nicaragua", "Central America"},
-	{"NL", "Netherlands", "Europe"},
-	{"NO", "Norway", "Europe"},
-	{"NP", "Nepal", "Asia"},
-	{"NR", "Nauru", "Oceania"},
-	{"NU", "Niue", "Oceania"},
-	{"NZ", "New Zealand (Aotearoa)", "Oceania"},
-	{"OM", "Oman", "Asia"},
-	{"PA", "Panama", "Central America"},
-	{"PE", "Peru", "South America"},
-	{"PF", "French Polynesia", "Oceania"},
-	{"PG", "Papua New Guinea", "Oceania"},
-	{"PH", "Philippines", "Asia"},
-	{"PK", "Pakistan", "Asia"},
-	{"PL", "Poland", "Europe"},
-	{"PM", "St. Pierre and Miquelon", "North America"},
-	{"PN", "Pitcairn", "Oceania"},
-	{"PR", "Puerto Rico", "Caribbean"},
-	{"PT", "Portugal", "Europe"},
-	{"PW", "Palau", "Oceania"},
-	{"PY", "Paraguay", "South America"},
-	{"QA", "Qatar", "Asia"},
-	{"RE", "Reunion", "Africa"},
-	{"RO", "Romania", "Europe"},
-	{"RU", "Russian Federation", "Europe"},
-	{"RW", "Rwanda", "Africa"},
-	{"SA", "Saudi Arabia", "Asia"},
-	{"SB", "Solomon Islands", "Oceania"},
-	{"SC", "Seychelles", "Africa"},
-	{"SD", "Sudan", "Africa"},
-	{"SE", "Sweden", "Europe"},
-	{"SG", "Singapore", "Asia"},
-	{"SH", "St. Helena", "Africa"},
-	{"SI", "Slovenia", "Europe"},
-	{"SJ", "Svalbard and Jan Mayen Islands", "Europe"},
-	{"SK", "Slovakia", "Europe"},
-	{"SL", "Sierra Leone", "Africa"},
-	{"SM", "San Marino", "Europe"},
-	{"SN", "Senegal", "Africa"},
-	{"SO", "Somalia", "Africa"},
-	{"SR", "Suriname", "South America"},
-	{"ST", "Sao Tome and Principe", "Africa"},
-	{"SV", "El Salvador", "Central America"},
-	{"SY", "Syrian Arab Republic", "Asia"},
-	{"SZ", "Swaziland", "Africa"},
-	{"TC", "Turks and Caicos Islands", "Caribbean"},
-	{"TD", "Chad", "Africa"},
-	{"TF", "French Southern Territories", "Antarctica"},
-	{"TG", "Togo", "Africa"},
-	{"TH", "Thailand", "Asia"},
-	{"TJ", "Tajikistan", "Asia"},
-	{"TK", "Tokelau", "Oceania"},
-	{"TM", "Turkmenistan", "Asia"},
-	{"TN", "Tunisia", "Africa"},
-	{"TO", "Tonga", "Oceania"},
-	{"TP", "East Timor", "Asia"},
-	{"TR", "Turkey", "Asia"},
-	{"TT", "Trinidad and Tobago", "Caribbean"},
-	{"TV", "Tuvalu", "Oceania"},
-	{"TW", "Taiwan", "Asia"},
-	{"TZ", "Tanzania", "Africa"},
-	{"UA", "Ukraine", "Europe"},
-	{"UG", "Uganda", "Africa"},
-	{"UM", "United States Minor Outlying Islands", "Oceania"},
-	{"US", "United States", "North America"},
-	{"UY", "Uruguay", "South America"},
-	{"UZ", "Uzbekistan", "Asia"},
-	{"VA", "Vatican City State (Holy See)", "Europe"},
-	{"VC", "St. Vincent and the Grenadines", "Caribbean"},
-	{"VE", "Venezuela", "South America"},
-	{"VG", "Virgin Islands (British)", "Caribbean"},
-	{"VI", "Virgin Islands (U.S.)", "Caribbean"},
-	{"VN", "Viet Nam", "Asia"},
-	{"VU", "Vanuatu", "Oceania"},
-	{"WF", "Wallis and Futuna Islands", "Oceania"},
-	{"WS", "Samoa", "Oceania"},
-	{"YE", "Yemen", "Asia"},
-	{"YT", "Mayotte", "Africa"},
-	{"ZA", "South Africa", "Africa"},
-	{"ZM", "Zambia", "Africa"},
-	{"ZW", "Zimbabwe", "Africa"},
+// Is reports whether target is ErrInvalidCountryCode.
+func (e *InvalidCountryCodeError) Is(target error) bool {
+	return target == ErrInvalidCountryCode
 }
 
-var countryMap map[string]CountryContinent
-var continentMap map[string][]string
-
-func init() {
-	countryMap = make(map[string]CountryContinent)
-	continentMap = make(map[string][]string)
-
-	for _, country := range countryContinent {
-		countryMap[country.CountryCode] = country
-		continentMap[country.Continent] = append(continentMap[country.Continent], country.CountryCode)
-	}
+// countryContinent is a slice of CountryContinent
+var countryContinent = []CountryContinent{
+	{"AD", "Andorra", "Europe", "AND", "020"},
+	{"AE", "United Arab Emirates", "Asia", "ARE", "784"},
+	{"AF", "Afghanistan", "Asia", "AFG", "004"},
+	{"AG", "Antigua and Barbuda", "Caribbean", "ATG", "028"},
+	{"AI", "Anguilla", "Caribbean", "AIA", "660"},
+	{"AL", "Albania", "Europe", "ALB", "008"},
+	{"AM", "Armenia", "Asia", "ARM", "051"},
+	{"AO", "Angola", "Africa", "AGO", "024"},
+	{"AR", "Argentina", "South America", "ARG", "032"},
+	{"AS", "American Samoa", "Oceania", "ASM", "016"},
+	{"AT", "Austria", "Europe", "AUT", "040"},
+	{"AU", "Australia", "Oceania", "AUS", "036"},
+	{"AW", "Aruba", "Caribbean", "ABW", "533"},
+	{"AZ", "Azerbaijan", "Asia", "AZE", "031"},
+	{"BA", "Bosnia and Herzegovina", "Europe", "BIH", "070"},
+	{"BB", "Barbados", "Caribbean", "BRB", "052"},
+	{"BD", "Bangladesh", "Asia", "BGD", "050"},
+	{"BE", "Belgium", "Europe", "BEL", "056"},
+	{"BF", "Burkina Faso", "Africa", "BFA", "854"},
+	{"BG", "Bulgaria", "Europe", "BGR", "100"},
+	{"BH", "Bahrain", "Asia", "BHR", "048"},
+	{"BI", "Burundi", "Africa", "BDI", "108"},
+	{"BJ", "Benin", "Africa", "BEN", "204"},
+	{"BM", "Bermuda", "Caribbean", "BMU", "060"},
+	{"BN", "Brunei Darussalam", "Asia", "BRN", "096"},
+	{"BO", "Bolivia", "South America", "BOL", "068"},
+	{"BR", "Brazil", "South America", "BRA", "076"},
+	{"BS", "Bahamas", "Caribbean", "BHS", "044"},
+	{"BT", "Bhutan", "Asia", "BTN", "064"},
+	{"BW", "Botswana", "Africa", "BWA", "072"},
+	{"BY", "Belarus", "Europe", "BLR", "112"},
+	{"BZ", "Belize", "Central America", "BLZ", "084"},
+	{"CA", "Canada", "North America", "CAN", "124"},
+	{"CC", "Cocos (Keeling) Islands", "Asia", "CCK", "166"},
+	{"CF", "Central African Republic", "Africa", "CAF", "140"},
+	{"CD", "Congo", "Africa", "COD", "180"},
+	{"CH", "Switzerland", "Europe", "CHE", "756"},
+	{"CI", "Cote D'Ivoire (Ivory Coast)", "Africa", "CIV", "384"},
+	{"CK", "Cook Islands", "Oceania", "COK", "184"},
+	{"CL", "Chile", "South America", "CHL", "152"},
+	{"CM", "Cameroon", "Africa", "CMR", "120"},
+	{"CN", "China", "Asia", "CHN", "156"},
+	{"CO", "Colombia", "South America", "COL", "170"},
+	{"CR", "Costa Rica", "Central America", "CRI", "188"},
+	{"CU", "Cuba", "Caribbean", "CUB", "192"},
+	{"CV", "Cape Verde", "Africa", "CPV", "132"},
+	{"CX", "Christmas Island", "Asia", "CXR", "162"},
+	{"CY", "Cyprus", "Asia", "CYP", "196"},
+	{"CZ", "Czech Republic", "Europe", "CZE", "203"},
+	{"DE", "Germany", "Europe", "DEU", "276"},
+	{"DJ", "Djibouti", "Africa", "DJI", "262"},
+	{"DK", "Denmark", "Europe", "DNK", "208"},
+	{"DM", "Dominica", "Caribbean", "DMA", "212"},
+	{"DO", "Dominican Republic", "Caribbean", "DOM", "214"},
+	{"DZ", "Algeria", "Africa", "DZA", "012"},
+	{"EC", "Ecuador", "South America", "ECU", "218"},
+	{"EE", "Estonia", "Europe", "EST", "233"},
+	{"EG", "Egypt", "Africa", "EGY", "818"},
+	{"EH", "Western Sahara", "Africa", "ESH", "732"},
+	{"ER", "Eritrea", "Africa", "ERI", "232"},
+	{"ES", "Spain", "Europe", "ESP", "724"},
+	{"ET", "Ethiopia", "Africa", "ETH", "231"},
+	{"FI", "Finland", "Europe", "FIN", "246"},
+	{"FJ", "Fiji", "Oceania", "FJI", "242"},
+	{"FK", "Falkland Islands (Malvinas)", "South America", "FLK", "238"},
+	{"FM", "Micronesia", "Oceania", "FSM", "583"},
+	{"FO", "Faroe Islands", "Europe", "FRO", "234"},
+	{"FR", "France", "Europe", "FRA", "250"},
+	{"GA", "Gabon", "Africa", "GAB", "266"},
+	{"GB", "United Kingdom", "Europe", "GBR", "826"},
+	{"GD", "Grenada", "Caribbean", "GRD", "308"},
+	{"GE", "Georgia", "Asia", "GEO", "268"},
+	{"GF", "French Guiana", "South America", "GUF", "254"},
+	{"GH", "Ghana", "Africa", "GHA", "288"},
+	{"GI", "Gibraltar", "Europe", "GIB", "292"},
+	{"GL", "Greenland", "North America", "GRL", "304"},
+	{"GM", "Gambia", "Africa", "GMB", "270"},
+	{"GN", "Guinea", "Africa", "GIN", "324"},
+	{"GP", "Guadeloupe", "Caribbean", "GLP", "312"},
+	{"GQ", "Equatorial Guinea", "Africa", "GNQ", "226"},
+	{"GR", "Greece", "Europe", "GRC", "300"},
+	{"GS", "S. Georgia and S. Sandwich Isls.", "South America", "SGS", "239"},
+	{"GT", "Guatemala", "Central America", "GTM", "320"},
+	{"GU", "Guam", "Oceania", "GUM", "316"},
+	{"GW", "Guinea-Bissau", "Africa", "GNB", "624"},
+	{"GY", "Guyana", "South America", "GUY", "328"},
+	{"HK", "Hong Kong", "Asia", "HKG", "344"},
+	{"HN", "Honduras", "Central America", "HND", "340"},
+	{"HR", "Croatia (Hrvatska)", "Europe", "HRV", "191"},
+	{"HT", "Haiti", "Caribbean", "HTI", "332"},
+	{"HU", "Hungary", "Europe", "HUN", "348"},
+	{"ID", "Indonesia", "Asia", "IDN", "360"},
+	{"IE", "Ireland", "Europe", "IRL", "372"},
+	{"IL", "Israel", "Asia", "ISR", "376"},
+	{"IN", "India", "Asia", "IND", "356"},
+	{"IO", "British Indian Ocean Territory", "Asia", "IOT", "086"},
+	{"IQ", "Iraq", "Asia", "IRQ", "368"},
+	{"IR", "Iran", "Asia", "IRN", "364"},
+	{"IS", "Iceland", "Europe", "ISL", "352"},
+	{"IT", "Italy", "Europe", "ITA", "380"},
+	{"JM", "Jamaica", "Caribbean", "JAM", "388"},
+	{"JO", "Jordan", "Asia", "JOR", "400"},
+	{"JP", "Japan", "Asia", "JPN", "392"},
+	{"KE", "Kenya", "Africa", "KEN", "404"},
+	{"KG", "Kyrgyzstan", "Asia", "KGZ", "417"},
+	{"KH", "Cambodia", "Asia", "KHM", "116"},
+	{"KI", "Kiribati", "Oceania", "KIR", "296"},
+	{"KM", "Comoros", "Africa", "COM", "174"},
+	{"KN", "Saint Kitts and Nevis", "Caribbean", "KNA", "659"},
+	{"KP", "Korea (North)", "Asia", "PRK", "408"},
+	{"KR", "Korea (South)", "Asia", "KOR", "410"},
+	{"KW", "Kuwait", "Asia", "KWT", "414"},
+	{"KY", "Cayman Islands", "Caribbean", "CYM", "136"},
+	{"KZ", "Kazakhstan", "Asia", "KAZ", "398"},
+	{"LA", "Laos", "Asia", "LAO", "418"},
+	{"LB", "Lebanon", "Asia", "LBN", "422"},
+	{"LC", "Saint Lucia", "Caribbean", "LCA", "662"},
+	{"LI", "Liechtenstein", "Europe", "LIE", "438"},
+	{"LK", "Sri Lanka", "Asia", "LKA", "144"},
+	{"LR", "Liberia", "Africa", "LBR", "430"},
+	{"LS", "Lesotho", "Africa", "LSO", "426"},
+	{"LT", "Lithuania", "Europe", "LTU", "440"},
+	{"LU", "Luxembourg", "Europe", "LUX", "442"},
+	{"LV", "Latvia", "Europe", "LVA", "428"},
+	{"LY", "Libya", "Africa", "LBY", "434"},
+	{"MA", "Morocco", "Africa", "MAR", "504"},
+	{"MC", "Monaco", "Europe", "MCO", "492"},
+	{"MD", "Moldova", "Europe", "MDA", "498"},
+	{"MG", "Madagascar", "Africa", "MDG", "450"},
+	{"MH", "Marshall Islands", "Oceania", "MHL", "584"},
+	{"MK", "Macedonia", "Europe", "MKD", "807"},
+	{"ML", "Mali", "Africa", "MLI", "466"},
+	{"MM", "Myanmar", "Asia", "MMR", "104"},
+	{"MN", "Mongolia", "Asia", "MNG", "496"},
+	{"MO", "Macau", "Asia", "MAC", "446"},
+	{"MP", "Northern Mariana Islands", "Oceania", "MNP", "580"},
+	{"MQ", "Martinique", "Caribbean", "MTQ", "474"},
+	{"MR", "Mauritania", "Africa", "MRT", "478"},
+	{"MS", "Montserrat", "Caribbean", "MSR", "500"},
+	{"MT", "Malta", "Europe", "MLT", "470"},
+	{"MU", "Mauritius", "Africa", "MUS", "480"},
+	{"MV", "Maldives", "Asia", "MDV", "462"},
+	{"MW", "Malawi", "Africa", "MWI", "454"},
+	{"MX", "Mexico", "North America", "MEX", "484"},
+	{"MY", "Malaysia", "Asia", "MYS", "458"},
+	{"MZ", "Mozambique", "Africa", "MOZ", "508"},
+	{"NA", "Namibia", "Africa", "NAM", "516"},
+	{"NC", "New Caledonia", "Oceania", "NCL", "540"},
+	{"NE", "Niger", "Africa", "NER", "562"},
+	{"NF", "Norfolk Island", "Oceania", "NFK", "574"},
+	{"NG", "Nigeria", "Africa", "NGA", "566"},
+	{"NI", "Nicaragua", "Central America", "NIC", "558"},
+	{"NL", "Netherlands", "Europe", "NLD", "528"},
+	{"NO", "Norway", "Europe", "NOR", "578"},
+	{"NP", "Nepal", "Asia", "NPL", "524"},
+	{"NR", "Nauru", "Oceania", "NRU", "520"},
+	{"NU", "Niue", "Oceania", "NIU", "570"},
+	{"NZ", "New Zealand (Aotearoa)", "Oceania", "NZL", "554"},
+	{"OM", "Oman", "Asia", "OMN", "512"},
+	{"PA", "Panama", "Central America", "PAN", "591"},
+	{"PE", "Peru", "South America", "PER", "604"},
+	{"PF", "French Polynesia", "Oceania", "PYF", "258"},
+	{"PG", "Papua New Guinea", "Oceania", "PNG", "598"},
+	{"PH", "Philippines", "Asia", "PHL", "608"},
+	{"PK", "Pakistan", "Asia", "PAK", "586"},
+	{"PL", "Poland", "Europe", "POL", "616"},
+	{"PM", "St. Pierre and Miquelon", "North America", "SPM", "666"},
+	{"PN", "Pitcairn", "Oceania", "PCN", "612"},
+	{"PR", "Puerto Rico", "Caribbean", "PRI", "630"},
+	{"PT", "Portugal", "Europe", "PRT", "620"},
+	{"PW", "Palau", "Oceania", "PLW", "585"},
+	{"PY", "Paraguay", "South America", "PRY", "600"},
+	{"QA", "Qatar", "Asia", "QAT", "634"},
+	{"RE", "Reunion", "Africa", "REU", "638"},
+	{"RO", "Romania", "Europe", "ROU", "642"},
+	{"RU", "Russian Federation", "Europe", "RUS", "643"},
+	{"RW", "Rwanda", "Africa", "RWA", "646"},
+	{"SA", "Saudi Arabia", "Asia", "SAU", "682"},
+	{"SB", "Solomon Islands", "Oceania", "SLB", "090"},
+	{"SC", "Seychelles", "Africa", "SYC", "690"},
+	{"SD", "Sudan", "Africa", "SDN", "729"},
+	{"SE", "Sweden", "Europe", "SWE", "752"},
+	{"SG", "Singapore", "Asia", "SGP", "702"},
+	{"SH", "St. Helena", "Africa", "SHN", "654"},
+	{"SI", "Slovenia", "Europe", "SVN", "705"},
+	{"SJ", "Svalbard and Jan Mayen Islands", "Europe", "SJM", "744"},
+	{"SK", "Slovakia", "Europe", "SVK", "703"},
+	{"SL", "Sierra Leone", "Africa", "SLE", "694"},
+	{"SM", "San Marino", "Europe", "SMR", "674"},
+	{"SN", "Senegal", "Africa", "SEN", "686"},
+	{"SO", "Somalia", "Africa", "SOM", "706"},
+	{"SR", "Suriname", "South America", "SUR", "740"},
+	{"ST", "Sao Tome and Principe", "Africa", "STP", "678"},
+	{"SV", "El Salvador", "Central America", "SLV", "222"},
+	{"SY", "Syrian Arab Republic", "Asia", "SYR", "760"},
+	{"SZ", "Swaziland", "Africa", "SWZ", "748"},
+	{"TC", "Turks and Caicos Islands", "Caribbean", "TCA", "796"},
+	{"TD", "Chad", "Africa", "TCD", "148"},
+	{"TF", "French Southern Territories", "Antarctica", "ATF", "260"},
+	{"TG", "Togo", "Africa", "TGO", "768"},
+	{"TH", "Thailand", "Asia", "THA", "764"},
+	{"TJ", "Tajikistan", "Asia", "TJK", "762"},
+	{"TK", "Tokelau", "Oceania", "TKL", "772"},
+	{"TM", "Turkmenistan", "Asia", "TKM", "795"},
+	{"TN", "Tunisia", "Africa", "TUN", "788"},
+	{"TO", "Tonga", "Oceania", "TON", "776"},
+	{"TP", "East Timor", "Asia", "TLS", "626"},
+	{"TR", "Turkey", "Asia", "TUR", "792"},
+	{"TT", "Trinidad and Tobago", "Caribbean", "TTO", "780"},
+	{"TV", "Tuvalu", "Oceania", "TUV", "798"},
+	{"TW", "Taiwan", "Asia", "TWN", "158"},
+	{"TZ", "Tanzania", "Africa", "TZA", "834"},
+	{"UA", "Ukraine", "Europe", "UKR", "804"},
+	{"UG", "Uganda", "Africa", "UGA", "800"},
+	{"UM", "United States Minor Outlying Islands", "Oceania", "UMI", "581"},
+	{"US", "United States", "North America", "USA", "840"},
+	{"UY", "Uruguay", "South America", "URY", "858"},
+	{"UZ", "Uzbekistan", "Asia", "UZB", "860"},
+	{"VA", "Vatican City State (Holy See)", "Europe", "VAT", "336"},
+	{"VC", "St. Vincent and the Grenadines", "Caribbean", "VCT", "670"},
+	{"VE", "Venezuela", "South America", "VEN", "862"},
+	{"VG", "Virgin Islands (British)", "Caribbean", "VGB", "092"},
+	{"VI", "Virgin Islands (U.S.)", "Caribbean", "VIR", "850"},
+	{"VN", "Viet Nam", "Asia", "VNM", "704"},
+	{"VU", "Vanuatu", "Oceania", "VUT", "548"},
+	{"WF", "Wallis and Futuna Islands", "Oceania", "WLF", "876"},
+	{"WS", "Samoa", "Oceania", "WSM", "882"},
+	{"YE", "Yemen", "Asia", "YEM", "887"},
+	{"YT", "Mayotte", "Africa", "MYT", "175"},
+	{"ZA", "South Africa", "Africa", "ZAF", "710"},
+	{"ZM", "Zambia", "Africa", "ZMB", "894"},
+	{"ZW", "Zimbabwe", "Africa", "ZWE", "716"},
 }
 
 // isValidCountryCode checks if the country code is a 2-letter uppercase string.
@@ -313,45 +359,21 @@ func isValidCountryCode(code string) bool {
 
 // CountryGetFullName returns the full name of the country with the given country code.
 func CountryGetFullName(countryCode string) (string, error) {
-	if !isValidCountryCode(countryCode) {
-		return "", &InvalidCountryCodeError{CountryCode: countryCode}
-	}
-	country, ok := countryMap[countryCode]
-	if !ok {
-		return "", &CountryNotFoundError{CountryCode: countryCode}
-	}
-	return country.CountryName, nil
+	return defaultRegistry.CountryGetFullName(countryCode)
 }
 
 // CountryGetFullNameContinent returns the full name and continent of the country with the given country code.
 func CountryGetFullNameContinent(countryCode string) (string, string, error) {
-	if !isValidCountryCode(countryCode) {
-		return "", "", &InvalidCountryCodeError{CountryCode: countryCode}
-	}
-	country, ok := countryMap[countryCode]
-	if !ok {
-		return "", "", &CountryNotFoundError{CountryCode: countryCode}
-	}
-	return country.CountryName, country.Continent, nil
+	return defaultRegistry.CountryGetFullNameContinent(countryCode)
 }
 
 // CountryGetContinent returns the continent of a country from its country code.
 func CountryGetContinent(countryCode string) (string, error) {
-	if !isValidCountryCode(countryCode) {
-		return "", &InvalidCountryCodeError{CountryCode: countryCode}
-	}
-	country, ok := countryMap[countryCode]
-	if !ok {
-		return "", &CountryNotFoundError{CountryCode: countryCode}
-	}
-	return country.Continent, nil
+	return defaultRegistry.CountryGetContinent(countryCode)
 }
 
-// ContinentGetCountries returns a list of countries in a continent from its continent name.
+// ContinentGetCountries returns a list of countries in a continent from its continent name, sorted by
+// country code. The list is a copy that the caller may modify.
 func ContinentGetCountries(continent string) ([]string, error) {
-	countries, ok := continentMap[continent]
-	if !ok {
-		return nil, &ContinentNotFoundError{Continent: continent}
-	}
-	return countries, nil
+	return defaultRegistry.ContinentGetCountries(continent)
 }
@@ -1,6 +1,8 @@
 package countrycontinent
 
 import (
+	"errors"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
@@ -32,7 +34,7 @@ func TestCountryGetFullName(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			got, err := CountryGetFullName(tc.code)
-			if !reflect.DeepEqual(err, tc.expectedError) {
+			if !sameError(err, tc.expectedError) {
 				t.Errorf("CountryGetFullName(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
 			}
 			if err == nil && got != tc.expected { // Only check 'got' if no error was expected
@@ -74,6 +76,42 @@ func TestErrorMessages(t *testing.T) {
 	}
 }
 
+func TestErrorsIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{name: "CountryNotFoundError", err: &CountryNotFoundError{CountryCode: "XX"}, target: ErrCountryNotFound, want: true},
+		{name: "ContinentNotFoundError", err: &ContinentNotFoundError{Continent: "Mars"}, target: ErrContinentNotFound, want: true},
+		{name: "InvalidCountryCodeError", err: &InvalidCountryCodeError{CountryCode: "x"}, target: ErrInvalidCountryCode, want: true},
+		{name: "CountryNameNotFoundError", err: &CountryNameNotFoundError{Name: "Atlantis"}, target: ErrCountryNotFound, want: true},
+		{name: "RegionNotFoundError", err: &RegionNotFoundError{Region: "999"}, target: ErrRegionNotFound, want: true},
+		{name: "Wrapped", err: fmt.Errorf("enrich row 12: %w", &CountryNotFoundError{CountryCode: "XX"}), target: ErrCountryNotFound, want: true},
+		{name: "Wrapped twice", err: fmt.Errorf("batch: %w", fmt.Errorf("row: %w", &InvalidCountryCodeError{CountryCode: "x"})), target: ErrInvalidCountryCode, want: true},
+		{name: "Patch error", err: &PatchError{Err: &CountryNotFoundError{CountryCode: "XX"}}, target: ErrCountryNotFound, want: true},
+		{name: "Other category", err: &CountryNotFoundError{CountryCode: "XX"}, target: ErrInvalidCountryCode, want: false},
+		{name: "Other error", err: errors.New("country code not found"), target: ErrCountryNotFound, want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := errors.Is(tc.err, tc.target); got != tc.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", tc.err, tc.target, got, tc.want)
+			}
+		})
+	}
+
+	_, err := CountryGetFullName("fr")
+	if !errors.Is(err, ErrInvalidCountryCode) {
+		t.Errorf("CountryGetFullName(fr) error = %v, want it to match ErrInvalidCountryCode", err)
+	}
+	_, err = ContinentGetCountries("Mars")
+	if !errors.Is(err, ErrContinentNotFound) {
+		t.Errorf("ContinentGetCountries(Mars) error = %v, want it to match ErrContinentNotFound", err)
+	}
+}
+
 func TestCountryGetFullNameContinent(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -103,7 +141,7 @@ func TestCountryGetFullNameContinent(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			gotCountry, gotContinent, err := CountryGetFullNameContinent(tc.code)
-			if !reflect.DeepEqual(err, tc.expectedError) {
+			if !sameError(err, tc.expectedError) {
 				t.Errorf("CountryGetFullNameContinent(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
 			}
 			if err == nil && (gotCountry != tc.wantCountry || gotContinent != tc.wantContinent) {
@@ -183,7 +221,7 @@ func TestCountryGetContinent(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			got, err := CountryGetContinent(tc.code)
-			if !reflect.DeepEqual(err, tc.expectedError) {
+			if !sameError(err, tc.expectedError) {
 				t.Errorf("CountryGetContinent(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
 			}
 			if err == nil && got != tc.want {
@@ -193,6 +231,15 @@ func TestCountryGetContinent(t *testing.T) {
 	}
 }
 
+// sameError reports whether got and want have the same type and message. Unlike reflect.DeepEqual,
+// it ignores the suggestions carried by not-found errors.
+func sameError(got, want error) bool {
+	if got == nil || want == nil {
+		return got == want
+	}
+	return reflect.TypeOf(got) == reflect.TypeOf(want) && got.Error() == want.Error()
+}
+
 // StringInSlice checks if a string is present in a slice of strings, performing a case-insensitive comparison.
 func StringInSlice(country string, s []string) bool {
 	uppercaseCountry := strings.ToUpper(country)
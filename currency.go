@@ -0,0 +1,308 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	Code       string // ISO 4217 alphabetic code
+	Numeric    string // ISO 4217 numeric code
+	Name       string // Name of the currency
+	MinorUnits int    // Number of digits after the decimal separator
+}
+
+// ErrCurrencyNotFound matches CurrencyNotFoundError with errors.Is.
+var ErrCurrencyNotFound = errors.New("currency not found")
+
+// CurrencyNotFoundError is returned when an ISO 4217 currency code is not found.
+// It matches ErrCurrencyNotFound.
+type CurrencyNotFoundError struct {
+	Currency string
+}
+
+func (e *CurrencyNotFoundError) Error() string {
+	return fmt.Sprintf("currency not found: %s", e.Currency)
+}
+
+// Is reports whether target is ErrCurrencyNotFound.
+func (e *CurrencyNotFoundError) Is(target error) bool {
+	return target == ErrCurrencyNotFound
+}
+
+// currencies holds the ISO 4217 currencies in use in the countries of the table, sorted by code.
+// Fund codes, precious metals and withdrawn currencies are left out.
+var currencies = []Currency{
+	{"AED", "784", "UAE Dirham", 2},
+	{"AFN", "971", "Afghani", 2},
+	{"ALL", "008", "Lek", 2},
+	{"AMD", "051", "Armenian Dram", 2},
+	{"AOA", "973", "Kwanza", 2},
+	{"ARS", "032", "Argentine Peso", 2},
+	{"AUD", "036", "Australian Dollar", 2},
+	{"AWG", "533", "Aruban Florin", 2},
+	{"AZN", "944", "Azerbaijan Manat", 2},
+	{"BAM", "977", "Convertible Mark", 2},
+	{"BBD", "052", "Barbados Dollar", 2},
+	{"BDT", "050", "Taka", 2},
+	{"BHD", "048", "Bahraini Dinar", 3},
+	{"BIF", "108", "Burundi Franc", 0},
+	{"BMD", "060", "Bermudian Dollar", 2},
+	{"BND", "096", "Brunei Dollar", 2},
+	{"BOB", "068", "Boliviano", 2},
+	{"BRL", "986", "Brazilian Real", 2},
+	{"BSD", "044", "Bahamian Dollar", 2},
+	{"BTN", "064", "Ngultrum", 2},
+	{"BWP", "072", "Pula", 2},
+	{"BYN", "933", "Belarusian Ruble", 2},
+	{"BZD", "084", "Belize Dollar", 2},
+	{"CAD", "124", "Canadian Dollar", 2},
+	{"CDF", "976", "Congolese Franc", 2},
+	{"CHF", "756", "Swiss Franc", 2},
+	{"CLP", "152", "Chilean Peso", 0},
+	{"CNY", "156", "Yuan Renminbi", 2},
+	{"COP", "170", "Colombian Peso", 2},
+	{"CRC", "188", "Costa Rican Colon", 2},
+	{"CUP", "192", "Cuban Peso", 2},
+	{"CVE", "132", "Cabo Verde Escudo", 2},
+	{"CZK", "203", "Czech Koruna", 2},
+	{"DJF", "262", "Djibouti Franc", 0},
+	{"DKK", "208", "Danish Krone", 2},
+	{"DOP", "214", "Dominican Peso", 2},
+	{"DZD", "012", "Algerian Dinar", 2},
+	{"EGP", "818", "Egyptian Pound", 2},
+	{"ERN", "232", "Nakfa", 2},
+	{"ETB", "230", "Ethiopian Birr", 2},
+	{"EUR", "978", "Euro", 2},
+	{"FJD", "242", "Fiji Dollar", 2},
+	{"FKP", "238", "Falkland Islands Pound", 2},
+	{"GBP", "826", "Pound Sterling", 2},
+	{"GEL", "981", "Lari", 2},
+	{"GHS", "936", "Ghana Cedi", 2},
+	{"GIP", "292", "Gibraltar Pound", 2},
+	{"GMD", "270", "Dalasi", 2},
+	{"GNF", "324", "Guinean Franc", 0},
+	{"GTQ", "320", "Quetzal", 2},
+	{"GYD", "328", "Guyana Dollar", 2},
+	{"HKD", "344", "Hong Kong Dollar", 2},
+	{"HNL", "340", "Lempira", 2},
+	{"HTG", "332", "Gourde", 2},
+	{"HUF", "348", "Forint", 2},
+	{"IDR", "360", "Rupiah", 2},
+	{"ILS", "376", "New Israeli Sheqel", 2},
+	{"INR", "356", "Indian Rupee", 2},
+	{"IQD", "368", "Iraqi Dinar", 3},
+	{"IRR", "364", "Iranian Rial", 2},
+	{"ISK", "352", "Iceland Krona", 0},
+	{"JMD", "388", "Jamaican Dollar", 2},
+	{"JOD", "400", "Jordanian Dinar", 3},
+	{"JPY", "392", "Yen", 0},
+	{"KES", "404", "Kenyan Shilling", 2},
+	{"KGS", "417", "Som", 2},
+	{"KHR", "116", "Riel", 2},
+	{"KMF", "174", "Comorian Franc", 0},
+	{"KPW", "408", "North Korean Won", 2},
+	{"KRW", "410", "Won", 0},
+	{"KWD", "414", "Kuwaiti Dinar", 3},
+	{"KYD", "136", "Cayman Islands Dollar", 2},
+	{"KZT", "398", "Tenge", 2},
+	{"LAK", "418", "Lao Kip", 2},
+	{"LBP", "422", "Lebanese Pound", 2},
+	{"LKR", "144", "Sri Lanka Rupee", 2},
+	{"LRD", "430", "Liberian Dollar", 2},
+	{"LSL", "426", "Loti", 2},
+	{"LYD", "434", "Libyan Dinar", 3},
+	{"MAD", "504", "Moroccan Dirham", 2},
+	{"MDL", "498", "Moldovan Leu", 2},
+	{"MGA", "969", "Malagasy Ariary", 2},
+	{"MKD", "807", "Denar", 2},
+	{"MMK", "104", "Kyat", 2},
+	{"MNT", "496", "Tugrik", 2},
+	{"MOP", "446", "Pataca", 2},
+	{"MRU", "929", "Ouguiya", 2},
+	{"MUR", "480", "Mauritius Rupee", 2},
+	{"MVR", "462", "Rufiyaa", 2},
+	{"MWK", "454", "Malawi Kwacha", 2},
+	{"MXN", "484", "Mexican Peso", 2},
+	{"MYR", "458", "Malaysian Ringgit", 2},
+	{"MZN", "943", "Mozambique Metical", 2},
+	{"NAD", "516", "Namibia Dollar", 2},
+	{"NGN", "566", "Naira", 2},
+	{"NIO", "558", "Cordoba Oro", 2},
+	{"NOK", "578", "Norwegian Krone", 2},
+	{"NPR", "524", "Nepalese Rupee", 2},
+	{"NZD", "554", "New Zealand Dollar", 2},
+	{"OMR", "512", "Rial Omani", 3},
+	{"PAB", "590", "Balboa", 2},
+	{"PEN", "604", "Sol", 2},
+	{"PGK", "598", "Kina", 2},
+	{"PHP", "608", "Philippine Peso", 2},
+	{"PKR", "586", "Pakistan Rupee", 2},
+	{"PLN", "985", "Zloty", 2},
+	{"PYG", "600", "Guarani", 0},
+	{"QAR", "634", "Qatari Rial", 2},
+	{"RON", "946", "Romanian Leu", 2},
+	{"RUB", "643", "Russian Ruble", 2},
+	{"RWF", "646", "Rwanda Franc", 0},
+	{"SAR", "682", "Saudi Riyal", 2},
+	{"SBD", "090", "Solomon Islands Dollar", 2},
+	{"SCR", "690", "Seychelles Rupee", 2},
+	{"SDG", "938", "Sudanese Pound", 2},
+	{"SEK", "752", "Swedish Krona", 2},
+	{"SGD", "702", "Singapore Dollar", 2},
+	{"SHP", "654", "Saint Helena Pound", 2},
+	{"SLE", "925", "Leone", 2},
+	{"SOS", "706", "Somali Shilling", 2},
+	{"SRD", "968", "Surinam Dollar", 2},
+	{"STN", "930", "Dobra", 2},
+	{"SVC", "222", "El Salvador Colon", 2},
+	{"SYP", "760", "Syrian Pound", 2},
+	{"SZL", "748", "Lilangeni", 2},
+	{"THB", "764", "Baht", 2},
+	{"TJS", "972", "Somoni", 2},
+	{"TMT", "934", "Turkmenistan New Manat", 2},
+	{"TND", "788", "Tunisian Dinar", 3},
+	{"TOP", "776", "Pa’anga", 2},
+	{"TRY", "949", "Turkish Lira", 2},
+	{"TTD", "780", "Trinidad and Tobago Dollar", 2},
+	{"TWD", "901", "New Taiwan Dollar", 2},
+	{"TZS", "834", "Tanzanian Shilling", 2},
+	{"UAH", "980", "Hryvnia", 2},
+	{"UGX", "800", "Uganda Shilling", 0},
+	{"USD", "840", "US Dollar", 2},
+	{"UYU", "858", "Peso Uruguayo", 2},
+	{"UZS", "860", "Uzbekistan Sum", 2},
+	{"VES", "928", "Bolívar Soberano", 2},
+	{"VND", "704", "Dong", 0},
+	{"VUV", "548", "Vatu", 0},
+	{"WST", "882", "Tala", 2},
+	{"XAF", "950", "CFA Franc BEAC", 0},
+	{"XCD", "951", "East Caribbean Dollar", 2},
+	{"XOF", "952", "CFA Franc BCEAO", 0},
+	{"XPF", "953", "CFP Franc", 0},
+	{"YER", "886", "Yemeni Rial", 2},
+	{"ZAR", "710", "Rand", 2},
+	{"ZMW", "967", "Zambian Kwacha", 2},
+	{"ZWG", "924", "Zimbabwe Gold", 2},
+}
+
+// countryCurrencies holds the ISO 4217 codes of the currencies in use in each country, the main
+// currency first, keyed by alpha-2 country code. Territories list the currency they share with
+// another country, such as the euro in Martinique or the Australian dollar in Kiribati.
+var countryCurrencies = map[string][]string{
+	"AD": {"EUR"}, "AE": {"AED"}, "AF": {"AFN"}, "AG": {"XCD"}, "AI": {"XCD"}, "AL": {"ALL"},
+	"AM": {"AMD"}, "AO": {"AOA"}, "AR": {"ARS"}, "AS": {"USD"}, "AT": {"EUR"}, "AU": {"AUD"},
+	"AW": {"AWG"}, "AZ": {"AZN"}, "BA": {"BAM"}, "BB": {"BBD"}, "BD": {"BDT"}, "BE": {"EUR"},
+	"BF": {"XOF"}, "BG": {"EUR"}, "BH": {"BHD"}, "BI": {"BIF"}, "BJ": {"XOF"}, "BM": {"BMD"},
+	"BN": {"BND"}, "BO": {"BOB"}, "BR": {"BRL"}, "BS": {"BSD"}, "BT": {"BTN", "INR"}, "BW": {"BWP"},
+	"BY": {"BYN"}, "BZ": {"BZD"}, "CA": {"CAD"}, "CC": {"AUD"}, "CD": {"CDF"}, "CF": {"XAF"},
+	"CH": {"CHF"}, "CI": {"XOF"}, "CK": {"NZD"}, "CL": {"CLP"}, "CM": {"XAF"}, "CN": {"CNY"},
+	"CO": {"COP"}, "CR": {"CRC"}, "CU": {"CUP"}, "CV": {"CVE"}, "CX": {"AUD"}, "CY": {"EUR"},
+	"CZ": {"CZK"}, "DE": {"EUR"}, "DJ": {"DJF"}, "DK": {"DKK"}, "DM": {"XCD"}, "DO": {"DOP"},
+	"DZ": {"DZD"}, "EC": {"USD"}, "EE": {"EUR"}, "EG": {"EGP"}, "EH": {"MAD"}, "ER": {"ERN"},
+	"ES": {"EUR"}, "ET": {"ETB"}, "FI": {"EUR"}, "FJ": {"FJD"}, "FK": {"FKP"}, "FM": {"USD"},
+	"FO": {"DKK"}, "FR": {"EUR"}, "GA": {"XAF"}, "GB": {"GBP"}, "GD": {"XCD"}, "GE": {"GEL"},
+	"GF": {"EUR"}, "GH": {"GHS"}, "GI": {"GIP"}, "GL": {"DKK"}, "GM": {"GMD"}, "GN": {"GNF"},
+	"GP": {"EUR"}, "GQ": {"XAF"}, "GR": {"EUR"}, "GS": {"GBP"}, "GT": {"GTQ"}, "GU": {"USD"},
+	"GW": {"XOF"}, "GY": {"GYD"}, "HK": {"HKD"}, "HN": {"HNL"}, "HR": {"EUR"}, "HT": {"HTG", "USD"},
+	"HU": {"HUF"}, "ID": {"IDR"}, "IE": {"EUR"}, "IL": {"ILS"}, "IN": {"INR"}, "IO": {"USD"},
+	"IQ": {"IQD"}, "IR": {"IRR"}, "IS": {"ISK"}, "IT": {"EUR"}, "JM": {"JMD"}, "JO": {"JOD"},
+	"JP": {"JPY"}, "KE": {"KES"}, "KG": {"KGS"}, "KH": {"KHR"}, "KI": {"AUD"}, "KM": {"KMF"},
+	"KN": {"XCD"}, "KP": {"KPW"}, "KR": {"KRW"}, "KW": {"KWD"}, "KY": {"KYD"}, "KZ": {"KZT"},
+	"LA": {"LAK"}, "LB": {"LBP"}, "LC": {"XCD"}, "LI": {"CHF"}, "LK": {"LKR"}, "LR": {"LRD"},
+	"LS": {"LSL", "ZAR"}, "LT": {"EUR"}, "LU": {"EUR"}, "LV": {"EUR"}, "LY": {"LYD"}, "MA": {"MAD"},
+	"MC": {"EUR"}, "MD": {"MDL"}, "MG": {"MGA"}, "MH": {"USD"}, "MK": {"MKD"}, "ML": {"XOF"},
+	"MM": {"MMK"}, "MN": {"MNT"}, "MO": {"MOP"}, "MP": {"USD"}, "MQ": {"EUR"}, "MR": {"MRU"},
+	"MS": {"XCD"}, "MT": {"EUR"}, "MU": {"MUR"}, "MV": {"MVR"}, "MW": {"MWK"}, "MX": {"MXN"},
+	"MY": {"MYR"}, "MZ": {"MZN"}, "NA": {"NAD", "ZAR"}, "NC": {"XPF"}, "NE": {"XOF"}, "NF": {"AUD"},
+	"NG": {"NGN"}, "NI": {"NIO"}, "NL": {"EUR"}, "NO": {"NOK"}, "NP": {"NPR"}, "NR": {"AUD"},
+	"NU": {"NZD"}, "NZ": {"NZD"}, "OM": {"OMR"}, "PA": {"PAB", "USD"}, "PE": {"PEN"}, "PF": {"XPF"},
+	"PG": {"PGK"}, "PH": {"PHP"}, "PK": {"PKR"}, "PL": {"PLN"}, "PM": {"EUR"}, "PN": {"NZD"},
+	"PR": {"USD"}, "PT": {"EUR"}, "PW": {"USD"}, "PY": {"PYG"}, "QA": {"QAR"}, "RE": {"EUR"},
+	"RO": {"RON"}, "RU": {"RUB"}, "RW": {"RWF"}, "SA": {"SAR"}, "SB": {"SBD"}, "SC": {"SCR"},
+	"SD": {"SDG"}, "SE": {"SEK"}, "SG": {"SGD"}, "SH": {"SHP"}, "SI": {"EUR"}, "SJ": {"NOK"},
+	"SK": {"EUR"}, "SL": {"SLE"}, "SM": {"EUR"}, "SN": {"XOF"}, "SO": {"SOS"}, "SR": {"SRD"},
+	"ST": {"STN"}, "SV": {"USD", "SVC"}, "SY": {"SYP"}, "SZ": {"SZL"}, "TC": {"USD"}, "TD": {"XAF"},
+	"TF": {"EUR"}, "TG": {"XOF"}, "TH": {"THB"}, "TJ": {"TJS"}, "TK": {"NZD"}, "TM": {"TMT"},
+	"TN": {"TND"}, "TO": {"TOP"}, "TP": {"USD"}, "TR": {"TRY"}, "TT": {"TTD"}, "TV": {"AUD"},
+	"TW": {"TWD"}, "TZ": {"TZS"}, "UA": {"UAH"}, "UG": {"UGX"}, "UM": {"USD"}, "US": {"USD"},
+	"UY": {"UYU"}, "UZ": {"UZS"}, "VA": {"EUR"}, "VC": {"XCD"}, "VE": {"VES"}, "VG": {"USD"},
+	"VI": {"USD"}, "VN": {"VND"}, "VU": {"VUV"}, "WF": {"XPF"}, "WS": {"WST"}, "YE": {"YER"},
+	"YT": {"EUR"}, "ZA": {"ZAR"}, "ZM": {"ZMW"}, "ZW": {"ZWG"},
+}
+
+var currencyMap map[string]Currency
+var currencyCountries map[string][]string
+
+func init() {
+	currencyMap = make(map[string]Currency)
+	currencyCountries = make(map[string][]string)
+
+	for _, currency := range currencies {
+		currencyMap[currency.Code] = currency
+		currencyMap[currency.Numeric] = currency
+	}
+	for countryCode, codes := range countryCurrencies {
+		for _, code := range codes {
+			currencyCountries[code] = append(currencyCountries[code], countryCode)
+		}
+	}
+	for _, countryCodes := range currencyCountries {
+		sort.Strings(countryCodes)
+	}
+}
+
+// AllCurrencies returns the ISO 4217 currencies in use in the countries of the embedded table, sorted by code.
+func AllCurrencies() []Currency {
+	return append([]Currency(nil), currencies...)
+}
+
+// CurrencyGet returns the currency with the given ISO 4217 alphabetic ("EUR") or numeric ("978") code.
+func CurrencyGet(currency string) (Currency, error) {
+	c, ok := currencyMap[currency]
+	if !ok {
+		return Currency{}, &CurrencyNotFoundError{Currency: currency}
+	}
+	return c, nil
+}
+
+// CountryGetCurrencies returns the currencies in use in a country, the main currency first.
+func (r *Registry) CountryGetCurrencies(countryCode string) ([]Currency, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return nil, err
+	}
+	var result []Currency
+	for _, code := range countryCurrencies[countryCode] {
+		result = append(result, currencyMap[code])
+	}
+	return result, nil
+}
+
+// CurrencyGetCountries returns the countries using the currency with the given ISO 4217
+// alphabetic or numeric code, sorted by country code.
+func (r *Registry) CurrencyGetCountries(currency string) ([]string, error) {
+	c, err := CurrencyGet(currency)
+	if err != nil {
+		return nil, err
+	}
+	var countries []string
+	for _, countryCode := range currencyCountries[c.Code] {
+		if _, ok := r.countryMap[countryCode]; ok {
+			countries = append(countries, countryCode)
+		}
+	}
+	return countries, nil
+}
+
+// CountryGetCurrencies returns the currencies in use in a country, the main currency first.
+func CountryGetCurrencies(countryCode string) ([]Currency, error) {
+	return defaultRegistry.CountryGetCurrencies(countryCode)
+}
+
+// CurrencyGetCountries returns the countries using the currency with the given ISO 4217
+// alphabetic or numeric code, sorted by country code.
+func CurrencyGetCountries(currency string) ([]string, error) {
+	return defaultRegistry.CurrencyGetCountries(currency)
+}
@@ -0,0 +1,102 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryGetCurrencies(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          []Currency
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: []Currency{{"EUR", "978", "Euro", 2}}},
+		{name: "Japan has no minor units", code: "JP", want: []Currency{{"JPY", "392", "Yen", 0}}},
+		{name: "Kuwait has three minor units", code: "KW", want: []Currency{{"KWD", "414", "Kuwaiti Dinar", 3}}},
+		{name: "Territory using the euro", code: "MQ", want: []Currency{{"EUR", "978", "Euro", 2}}},
+		{name: "Several currencies", code: "BT", want: []Currency{{"BTN", "064", "Ngultrum", 2}, {"INR", "356", "Indian Rupee", 2}}},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetCurrencies(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetCurrencies(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CountryGetCurrencies(%s) = %v; want %v", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyGetCountries(t *testing.T) {
+	tests := []struct {
+		name          string
+		currency      string
+		want          []string
+		expectedError error
+	}{
+		{name: "Swiss franc", currency: "CHF", want: []string{"CH", "LI"}},
+		{name: "Numeric code", currency: "756", want: []string{"CH", "LI"}},
+		{name: "Rand", currency: "ZAR", want: []string{"LS", "NA", "ZA"}},
+		{name: "New Zealand dollar", currency: "NZD", want: []string{"CK", "NU", "NZ", "PN", "TK"}},
+		{name: "Unknown currency", currency: "XYZ", expectedError: &CurrencyNotFoundError{Currency: "XYZ"}},
+		{name: "Lowercase", currency: "chf", expectedError: &CurrencyNotFoundError{Currency: "chf"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CurrencyGetCountries(tc.currency)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CurrencyGetCountries(%s) error = %v, wantError %v", tc.currency, err, tc.expectedError)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CurrencyGetCountries(%s) = %v; want %v", tc.currency, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyGet(t *testing.T) {
+	if got, err := CurrencyGet("978"); err != nil || got.Code != "EUR" {
+		t.Errorf("CurrencyGet(978) = %v, %v; want EUR", got, err)
+	}
+	if _, err := CurrencyGet(""); !sameError(err, &CurrencyNotFoundError{Currency: ""}) {
+		t.Errorf("CurrencyGet() error = %v; want CurrencyNotFoundError", err)
+	}
+}
+
+func TestCurrencyTablesConsistent(t *testing.T) {
+	for _, c := range countryContinent {
+		if len(countryCurrencies[c.CountryCode]) == 0 {
+			t.Errorf("%s: no currency", c.CountryCode)
+		}
+	}
+	for countryCode, codes := range countryCurrencies {
+		if _, ok := defaultRegistry.countryMap[countryCode]; !ok {
+			t.Errorf("currencies for unknown country %s", countryCode)
+		}
+		for _, code := range codes {
+			if _, ok := currencyMap[code]; !ok {
+				t.Errorf("%s: unknown currency %s", countryCode, code)
+			}
+		}
+	}
+	for i, currency := range currencies {
+		if !isValidAlpha3Code(currency.Code) || !isValidNumericCode(currency.Numeric) {
+			t.Errorf("malformed currency %v", currency)
+		}
+		if i > 0 && currencies[i-1].Code >= currency.Code {
+			t.Errorf("currencies not sorted at %s", currency.Code)
+		}
+		if len(currencyCountries[currency.Code]) == 0 {
+			t.Errorf("currency %s is not used by any country", currency.Code)
+		}
+	}
+	if len(currencyMap) != 2*len(currencies) {
+		t.Errorf("currency codes are not unique: %d codes for %d currencies", len(currencyMap), len(currencies))
+	}
+}
@@ -0,0 +1,244 @@
+package countrycontinent
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// datasetColumns are the CSV header names and JSON field names of a dataset, in CountryContinent field order.
+var datasetColumns = []string{"country_code", "country_name", "continent", "alpha3", "numeric"}
+
+// DatasetProblem is a single validation failure in a dataset.
+type DatasetProblem struct {
+	Record  int    // 1-based index of the record in the dataset
+	Line    int    // Line of the record in the source, or 0 if unknown
+	Message string // Description of the problem
+}
+
+func (p DatasetProblem) String() string {
+	if p.Line > 0 {
+		return fmt.Sprintf("record %d (line %d): %s", p.Record, p.Line, p.Message)
+	}
+	return fmt.Sprintf("record %d: %s", p.Record, p.Message)
+}
+
+// DatasetError is returned when a dataset fails validation. It lists every problem found.
+type DatasetError struct {
+	Problems []DatasetProblem
+}
+
+func (e *DatasetError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		problems[i] = p.String()
+	}
+	return "invalid dataset: " + strings.Join(problems, "; ")
+}
+
+// validateCountries checks that every country has a unique, well-formed alpha-2 code, optional unique
+// alpha-3 and numeric codes, a name and a known continent. lines holds the source line of each country
+// and may be nil.
+func validateCountries(countries []CountryContinent, lines []int) error {
+	var problems []DatasetProblem
+	alpha2 := make(map[string]int)
+	alpha3 := make(map[string]int)
+	numeric := make(map[string]int)
+
+	for i, country := range countries {
+		problem := func(format string, args ...any) {
+			p := DatasetProblem{Record: i + 1, Message: fmt.Sprintf(format, args...)}
+			if lines != nil {
+				p.Line = lines[i]
+			}
+			problems = append(problems, p)
+		}
+		checkUnique := func(seen map[string]int, code string) {
+			if first, ok := seen[code]; ok {
+				problem("duplicate country code %s, first used by record %d", code, first)
+				return
+			}
+			seen[code] = i + 1
+		}
+
+		if !isValidCountryCode(country.CountryCode) {
+			problem("invalid country code format: %q", country.CountryCode)
+		} else {
+			checkUnique(alpha2, country.CountryCode)
+		}
+		if country.CountryCodeAlpha3 != "" {
+			if !isValidAlpha3Code(country.CountryCodeAlpha3) {
+				problem("invalid alpha-3 country code format: %q", country.CountryCodeAlpha3)
+			} else {
+				checkUnique(alpha3, country.CountryCodeAlpha3)
+			}
+		}
+		if country.CountryCodeNumeric != "" {
+			if !isValidNumericCode(country.CountryCodeNumeric) {
+				problem("invalid numeric country code format: %q", country.CountryCodeNumeric)
+			} else {
+				checkUnique(numeric, country.CountryCodeNumeric)
+			}
+		}
+		if strings.TrimSpace(country.CountryName) == "" {
+			problem("missing country name for %s", country.CountryCode)
+		}
+		if c, err := ParseContinent(country.Continent); err != nil || c.String() != country.Continent {
+			problem("unknown continent: %q", country.Continent)
+		}
+	}
+	if len(problems) > 0 {
+		return &DatasetError{Problems: problems}
+	}
+	return nil
+}
+
+// LoadCSV builds a registry from CSV data. The first row is a header naming the columns
+// country_code, country_name and continent, and optionally alpha3 and numeric, in any order.
+func LoadCSV(r io.Reader) (*Registry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("invalid dataset: missing CSV header")
+		}
+		return nil, err
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range datasetColumns[:3] {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("invalid dataset: missing CSV column %q", name)
+		}
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return record[i]
+		}
+		return ""
+	}
+
+	var countries []CountryContinent
+	var lines []int
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		countries = append(countries, CountryContinent{
+			CountryCode:        field(record, "country_code"),
+			CountryName:        field(record, "country_name"),
+			Continent:          field(record, "continent"),
+			CountryCodeAlpha3:  field(record, "alpha3"),
+			CountryCodeNumeric: field(record, "numeric"),
+		})
+		lines = append(lines, line)
+	}
+	return newRegistry(countries, lines)
+}
+
+// datasetRecord is the JSON representation of a country in a dataset.
+type datasetRecord struct {
+	CountryCode        string `json:"country_code"`
+	CountryName        string `json:"country_name"`
+	Continent          string `json:"continent"`
+	CountryCodeAlpha3  string `json:"alpha3,omitempty"`
+	CountryCodeNumeric string `json:"numeric,omitempty"`
+}
+
+// LoadJSON builds a registry from JSON data: an array of objects with the fields country_code,
+// country_name and continent, and optionally alpha3 and numeric.
+func LoadJSON(r io.Reader) (*Registry, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := decoder.Token(); err != nil || tok != json.Delim('[') {
+		return nil, errors.New("invalid dataset: expected a JSON array of countries")
+	}
+
+	var countries []CountryContinent
+	var lines []int
+	for decoder.More() {
+		offset := decoder.InputOffset()
+		var record datasetRecord
+		if err := decoder.Decode(&record); err != nil {
+			return nil, fmt.Errorf("invalid dataset: record %d: %w", len(countries)+1, err)
+		}
+		countries = append(countries, CountryContinent(record))
+		lines = append(lines, lineAt(data, offset))
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, fmt.Errorf("invalid dataset: %w", err)
+	}
+	return newRegistry(countries, lines)
+}
+
+// lineAt returns the line of the first non-space, non-separator byte at or after offset in data.
+func lineAt(data []byte, offset int64) int {
+	for offset < int64(len(data)) && strings.IndexByte(" \t\r\n,", data[offset]) >= 0 {
+		offset++
+	}
+	return 1 + bytes.Count(data[:offset], []byte("\n"))
+}
+
+// LoadCSVFile builds a registry from the named CSV file in fsys. See LoadCSV for the format.
+func LoadCSVFile(fsys fs.FS, name string) (*Registry, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadCSV(f)
+}
+
+// LoadJSONFile builds a registry from the named JSON file in fsys. See LoadJSON for the format.
+func LoadJSONFile(fsys fs.FS, name string) (*Registry, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadJSON(f)
+}
+
+// WriteCSV writes the countries of the registry as CSV, in the format read by LoadCSV.
+func (r *Registry) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(datasetColumns); err != nil {
+		return err
+	}
+	for _, country := range r.countries {
+		record := []string{country.CountryCode, country.CountryName, country.Continent, country.CountryCodeAlpha3, country.CountryCodeNumeric}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes the countries of the registry as a JSON array, in the format read by LoadJSON.
+func (r *Registry) WriteJSON(w io.Writer) error {
+	records := make([]datasetRecord, len(r.countries))
+	for i, country := range r.countries {
+		records[i] = datasetRecord(country)
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
@@ -0,0 +1,184 @@
+package countrycontinent
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const testCSV = `country_code,country_name,continent,alpha3,numeric
+FR,France,Europe,FRA,250
+VN,Vietnam,Asia,VNM,704
+XK,Kosovo,Europe,,
+`
+
+const testJSON = `[
+  {"country_code": "FR", "country_name": "France", "continent": "Europe", "alpha3": "FRA", "numeric": "250"},
+  {"country_code": "VN", "country_name": "Vietnam", "continent": "Asia", "alpha3": "VNM", "numeric": "704"},
+  {"country_code": "XK", "country_name": "Kosovo", "continent": "Europe"}
+]`
+
+func TestLoadDataset(t *testing.T) {
+	fsys := fstest.MapFS{
+		"countries.csv":  {Data: []byte(testCSV)},
+		"countries.json": {Data: []byte(testJSON)},
+	}
+	tests := []struct {
+		name string
+		load func() (*Registry, error)
+	}{
+		{name: "LoadCSV", load: func() (*Registry, error) { return LoadCSV(strings.NewReader(testCSV)) }},
+		{name: "LoadJSON", load: func() (*Registry, error) { return LoadJSON(strings.NewReader(testJSON)) }},
+		{name: "LoadCSVFile", load: func() (*Registry, error) { return LoadCSVFile(fsys, "countries.csv") }},
+		{name: "LoadJSONFile", load: func() (*Registry, error) { return LoadJSONFile(fsys, "countries.json") }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := tc.load()
+			if err != nil {
+				t.Fatalf("%s() error = %v", tc.name, err)
+			}
+			if name, _ := r.CountryGetFullName("VN"); name != "Vietnam" {
+				t.Errorf("CountryGetFullName(VN) = %s; want Vietnam", name)
+			}
+			if code, _ := r.Alpha3ToAlpha2("FRA"); code != "FR" {
+				t.Errorf("Alpha3ToAlpha2(FRA) = %s; want FR", code)
+			}
+			if got, _ := r.ContinentGetCountries("Europe"); !reflect.DeepEqual(got, []string{"FR", "XK"}) {
+				t.Errorf("ContinentGetCountries(Europe) = %v; want [FR XK]", got)
+			}
+		})
+	}
+}
+
+func TestLoadCSVColumnOrder(t *testing.T) {
+	r, err := LoadCSV(strings.NewReader("continent,country_code,country_name\nEurope,FR,France\n"))
+	if err != nil {
+		t.Fatalf("LoadCSV() error = %v", err)
+	}
+	if name, _ := r.CountryGetFullName("FR"); name != "France" {
+		t.Errorf("CountryGetFullName(FR) = %s; want France", name)
+	}
+}
+
+func TestLoadDatasetProblems(t *testing.T) {
+	const badCSV = `country_code,country_name,continent,alpha3,numeric
+FR,France,Europe,FRA,250
+fr,France,Europe,,
+FR,France again,Europe,FRX,251
+DE,Germany,Eurasia,DEU,276
+IT,Italy,Europe,FRA,380
+`
+	const badJSON = `[
+  {"country_code": "FR", "country_name": "France", "continent": "Europe"},
+  {"country_code": "FR", "country_name": "France", "continent": "Europe"},
+  {"country_code": "DE", "country_name": "Germany",
+   "continent": "Mars"}
+]`
+	tests := []struct {
+		name string
+		load func() (*Registry, error)
+		want []DatasetProblem
+	}{
+		{
+			name: "CSV",
+			load: func() (*Registry, error) { return LoadCSV(strings.NewReader(badCSV)) },
+			want: []DatasetProblem{
+				{Record: 2, Line: 3, Message: `invalid country code format: "fr"`},
+				{Record: 3, Line: 4, Message: "duplicate country code FR, first used by record 1"},
+				{Record: 4, Line: 5, Message: `unknown continent: "Eurasia"`},
+				{Record: 5, Line: 6, Message: "duplicate country code FRA, first used by record 1"},
+			},
+		},
+		{
+			name: "JSON",
+			load: func() (*Registry, error) { return LoadJSON(strings.NewReader(badJSON)) },
+			want: []DatasetProblem{
+				{Record: 2, Line: 3, Message: "duplicate country code FR, first used by record 1"},
+				{Record: 3, Line: 4, Message: `unknown continent: "Mars"`},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.load()
+			var datasetErr *DatasetError
+			if !errors.As(err, &datasetErr) {
+				t.Fatalf("load error = %v, want a *DatasetError", err)
+			}
+			if !reflect.DeepEqual(datasetErr.Problems, tc.want) {
+				t.Errorf("Problems = %v; want %v", datasetErr.Problems, tc.want)
+			}
+		})
+	}
+}
+
+func TestLoadDatasetMalformed(t *testing.T) {
+	tests := []struct {
+		name string
+		load func() (*Registry, error)
+	}{
+		{name: "Empty CSV", load: func() (*Registry, error) { return LoadCSV(strings.NewReader("")) }},
+		{name: "CSV missing column", load: func() (*Registry, error) { return LoadCSV(strings.NewReader("country_code,country_name\nFR,France\n")) }},
+		{name: "CSV wrong field count", load: func() (*Registry, error) {
+			return LoadCSV(strings.NewReader("country_code,country_name,continent\nFR,France\n"))
+		}},
+		{name: "JSON object", load: func() (*Registry, error) { return LoadJSON(strings.NewReader(`{"country_code": "FR"}`)) }},
+		{name: "JSON truncated", load: func() (*Registry, error) { return LoadJSON(strings.NewReader(`[{"country_code": "FR"`)) }},
+		{name: "Missing file", load: func() (*Registry, error) { return LoadJSONFile(fstest.MapFS{}, "missing.json") }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := tc.load(); err == nil {
+				t.Errorf("load error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestDatasetErrorMessage(t *testing.T) {
+	err := &DatasetError{Problems: []DatasetProblem{
+		{Record: 1, Line: 2, Message: "first"},
+		{Record: 3, Message: "second"},
+	}}
+	want := "invalid dataset: record 1 (line 2): first; record 3: second"
+	if err.Error() != want {
+		t.Errorf("Error() got %q, want %q", err.Error(), want)
+	}
+}
+
+func TestWriteDatasetRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(r *Registry, b *strings.Builder) error
+		load  func(s string) (*Registry, error)
+	}{
+		{
+			name:  "CSV",
+			write: func(r *Registry, b *strings.Builder) error { return r.WriteCSV(b) },
+			load:  func(s string) (*Registry, error) { return LoadCSV(strings.NewReader(s)) },
+		},
+		{
+			name:  "JSON",
+			write: func(r *Registry, b *strings.Builder) error { return r.WriteJSON(b) },
+			load:  func(s string) (*Registry, error) { return LoadJSON(strings.NewReader(s)) },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := tc.write(DefaultRegistry(), &b); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+			r, err := tc.load(b.String())
+			if err != nil {
+				t.Fatalf("load error = %v", err)
+			}
+			if !reflect.DeepEqual(r.Countries(), DefaultRegistry().Countries()) {
+				t.Errorf("round trip through %s changed the dataset", tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidFlagEmoji matches InvalidFlagEmojiError with errors.Is.
+var ErrInvalidFlagEmoji = errors.New("invalid flag emoji")
+
+// InvalidFlagEmojiError is returned when a string is neither a pair of regional indicator symbols
+// nor a subdivision flag tag sequence. It matches ErrInvalidFlagEmoji.
+type InvalidFlagEmojiError struct {
+	Flag string
+}
+
+func (e *InvalidFlagEmojiError) Error() string {
+	return fmt.Sprintf("invalid flag emoji: %q", e.Flag)
+}
+
+// Is reports whether target is ErrInvalidFlagEmoji.
+func (e *InvalidFlagEmojiError) Is(target error) bool {
+	return target == ErrInvalidFlagEmoji
+}
+
+const (
+	regionalIndicatorA = '\U0001F1E6' // REGIONAL INDICATOR SYMBOL LETTER A
+	blackFlag          = '\U0001F3F4' // WAVING BLACK FLAG, base of subdivision flags
+	tagDigitZero       = '\U000E0030' // TAG DIGIT ZERO
+	tagDigitNine       = '\U000E0039' // TAG DIGIT NINE
+	tagLatinSmallA     = '\U000E0061' // TAG LATIN SMALL LETTER A
+	tagLatinSmallZ     = '\U000E007A' // TAG LATIN SMALL LETTER Z
+	cancelTag          = '\U000E007F' // CANCEL TAG, end of subdivision flags
+)
+
+// FlagEmoji returns the flag emoji of the country with the given country code, as a pair of
+// regional indicator symbols ("FR" → "🇫🇷").
+func (r *Registry) FlagEmoji(countryCode string) (string, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return "", err
+	}
+	if code, ok := currentCodes[countryCode]; ok {
+		countryCode = code
+	}
+	var b strings.Builder
+	for _, c := range countryCode {
+		b.WriteRune(regionalIndicatorA + c - 'A')
+	}
+	return b.String(), nil
+}
+
+// CodeFromFlagEmoji returns the country code of a flag emoji. It accepts pairs of regional
+// indicator symbols ("🇫🇷" → "FR") and subdivision flag tag sequences, which resolve to their
+// country (the flag of England → "GB"). It returns an *InvalidFlagEmojiError if flag is not a flag
+// emoji and a *CountryNotFoundError if the flag's country is not in the registry.
+func (r *Registry) CodeFromFlagEmoji(flag string) (string, error) {
+	countryCode, _, err := parseFlagEmoji(flag)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := r.countryMap[countryCode]; !ok {
+		for code, current := range currentCodes {
+			if current == countryCode {
+				countryCode = code
+			}
+		}
+	}
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return "", err
+	}
+	return countryCode, nil
+}
+
+// SubdivisionFromFlagEmoji returns the ISO 3166-2 subdivision code of a subdivision flag tag
+// sequence, such as "GB-ENG", "GB-SCT" or "GB-WLS" for the flags of England, Scotland and Wales.
+// It returns an *InvalidFlagEmojiError if flag is not a subdivision flag.
+func (r *Registry) SubdivisionFromFlagEmoji(flag string) (string, error) {
+	countryCode, subdivision, err := parseFlagEmoji(flag)
+	if err != nil {
+		return "", err
+	}
+	if subdivision == "" {
+		return "", &InvalidFlagEmojiError{Flag: flag}
+	}
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return "", err
+	}
+	return countryCode + "-" + subdivision, nil
+}
+
+// parseFlagEmoji splits a flag emoji into its alpha-2 country code and, for subdivision flags,
+// its upper-case subdivision code.
+func parseFlagEmoji(flag string) (countryCode, subdivision string, err error) {
+	runes := []rune(flag)
+	if len(runes) == 2 {
+		var code strings.Builder
+		for _, c := range runes {
+			if c < regionalIndicatorA || c > regionalIndicatorA+'Z'-'A' {
+				return "", "", &InvalidFlagEmojiError{Flag: flag}
+			}
+			code.WriteRune('A' + c - regionalIndicatorA)
+		}
+		return code.String(), "", nil
+	}
+
+	// A subdivision flag is a black flag followed by the tag characters of the lower-case
+	// subdivision code without its hyphen ("gbeng") and a cancel tag.
+	if len(runes) < 5 || runes[0] != blackFlag || runes[len(runes)-1] != cancelTag {
+		return "", "", &InvalidFlagEmojiError{Flag: flag}
+	}
+	var tag strings.Builder
+	for i, c := range runes[1 : len(runes)-1] {
+		switch {
+		case c >= tagLatinSmallA && c <= tagLatinSmallZ:
+			tag.WriteRune('A' + c - tagLatinSmallA)
+		case c >= tagDigitZero && c <= tagDigitNine && i >= 2:
+			tag.WriteRune('0' + c - tagDigitZero)
+		default:
+			return "", "", &InvalidFlagEmojiError{Flag: flag}
+		}
+	}
+	code := tag.String()
+	if len(code) > 5 {
+		return "", "", &InvalidFlagEmojiError{Flag: flag}
+	}
+	return code[:2], code[2:], nil
+}
+
+// FlagEmoji returns the flag emoji of the country with the given country code, as a pair of
+// regional indicator symbols ("FR" → "🇫🇷").
+func FlagEmoji(countryCode string) (string, error) {
+	return defaultRegistry.FlagEmoji(countryCode)
+}
+
+// CodeFromFlagEmoji returns the country code of a flag emoji. It accepts pairs of regional
+// indicator symbols ("🇫🇷" → "FR") and subdivision flag tag sequences, which resolve to their
+// country (the flag of England → "GB").
+func CodeFromFlagEmoji(flag string) (string, error) {
+	return defaultRegistry.CodeFromFlagEmoji(flag)
+}
+
+// SubdivisionFromFlagEmoji returns the ISO 3166-2 subdivision code of a subdivision flag tag
+// sequence, such as "GB-ENG", "GB-SCT" or "GB-WLS" for the flags of England, Scotland and Wales.
+func SubdivisionFromFlagEmoji(flag string) (string, error) {
+	return defaultRegistry.SubdivisionFromFlagEmoji(flag)
+}
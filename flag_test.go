@@ -0,0 +1,114 @@
+package countrycontinent
+
+import (
+	"errors"
+	"testing"
+)
+
+const (
+	flagEngland  = "\U0001F3F4\U000E0067\U000E0062\U000E0065\U000E006E\U000E0067\U000E007F"
+	flagScotland = "\U0001F3F4\U000E0067\U000E0062\U000E0073\U000E0063\U000E0074\U000E007F"
+	flagWales    = "\U0001F3F4\U000E0067\U000E0062\U000E0077\U000E006C\U000E0073\U000E007F"
+	flagTexas    = "\U0001F3F4\U000E0075\U000E0073\U000E0074\U000E0078\U000E007F"
+)
+
+func TestFlagEmoji(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: "🇫🇷"},
+		{name: "Martinique", code: "MQ", want: "🇲🇶"},
+		{name: "East Timor uses the TL flag", code: "TP", want: "🇹🇱"},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FlagEmoji(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("FlagEmoji(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("FlagEmoji(%s) = %s; want %s", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCodeFromFlagEmoji(t *testing.T) {
+	tests := []struct {
+		name          string
+		flag          string
+		want          string
+		expectedError error
+	}{
+		{name: "France", flag: "🇫🇷", want: "FR"},
+		{name: "East Timor", flag: "🇹🇱", want: "TP"},
+		{name: "England", flag: flagEngland, want: "GB"},
+		{name: "Scotland", flag: flagScotland, want: "GB"},
+		{name: "Wales", flag: flagWales, want: "GB"},
+		{name: "Texas", flag: flagTexas, want: "US"},
+		{name: "Unknown country", flag: "🇽🇽", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Letters", flag: "FR", expectedError: &InvalidFlagEmojiError{Flag: "FR"}},
+		{name: "Single indicator", flag: "🇫", expectedError: &InvalidFlagEmojiError{Flag: "🇫"}},
+		{name: "Three indicators", flag: "🇫🇷🇦", expectedError: &InvalidFlagEmojiError{Flag: "🇫🇷🇦"}},
+		{name: "Black flag alone", flag: "🏴", expectedError: &InvalidFlagEmojiError{Flag: "🏴"}},
+		{name: "Missing cancel tag", flag: flagEngland[:len(flagEngland)-4], expectedError: &InvalidFlagEmojiError{Flag: flagEngland[:len(flagEngland)-4]}},
+		{name: "Empty", flag: "", expectedError: &InvalidFlagEmojiError{Flag: ""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CodeFromFlagEmoji(tc.flag)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CodeFromFlagEmoji(%q) error = %v, wantError %v", tc.flag, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("CodeFromFlagEmoji(%q) = %s; want %s", tc.flag, got, tc.want)
+			}
+		})
+	}
+	if _, err := CodeFromFlagEmoji("🇫"); !errors.Is(err, ErrInvalidFlagEmoji) {
+		t.Errorf("CodeFromFlagEmoji error = %v; want it to match ErrInvalidFlagEmoji", err)
+	}
+}
+
+func TestSubdivisionFromFlagEmoji(t *testing.T) {
+	tests := []struct {
+		name          string
+		flag          string
+		want          string
+		expectedError error
+	}{
+		{name: "England", flag: flagEngland, want: "GB-ENG"},
+		{name: "Scotland", flag: flagScotland, want: "GB-SCT"},
+		{name: "Wales", flag: flagWales, want: "GB-WLS"},
+		{name: "Texas", flag: flagTexas, want: "US-TX"},
+		{name: "Country flag", flag: "🇬🇧", expectedError: &InvalidFlagEmojiError{Flag: "🇬🇧"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := SubdivisionFromFlagEmoji(tc.flag)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("SubdivisionFromFlagEmoji(%q) error = %v, wantError %v", tc.flag, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("SubdivisionFromFlagEmoji(%q) = %s; want %s", tc.flag, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlagEmojiRoundTrip(t *testing.T) {
+	for _, c := range countryContinent {
+		flag, err := FlagEmoji(c.CountryCode)
+		if err != nil {
+			t.Fatalf("FlagEmoji(%s) error = %v", c.CountryCode, err)
+		}
+		if got, err := CodeFromFlagEmoji(flag); err != nil || got != c.CountryCode {
+			t.Errorf("CodeFromFlagEmoji(FlagEmoji(%s)) = %s, %v", c.CountryCode, got, err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+//go:build ignore
+
+// gen_boundaries generates boundaries.geojson, the country boundaries embedded for CountryAt, from
+// the Natural Earth 1:110m admin 0 countries GeoJSON, which is in the public domain:
+// https://www.naturalearthdata.com/downloads/110m-cultural-vectors/
+//
+// Only the alpha-2 country code of each feature is kept, and coordinates are rounded to the given
+// number of decimals: 2 (about 1 km) is finer than the 1:110m source and keeps the file small.
+//
+// Usage:
+//
+//	go run gen_boundaries.go [-i ne_110m_admin_0_countries.geojson] [-precision 2] [-o boundaries.geojson]
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// codeProperties are the Natural Earth properties holding the alpha-2 country code, newest first.
+var codeProperties = []string{"ISO_A2_EH", "ISO_A2", "iso_a2"}
+
+// breakawayCodes maps the Natural Earth code of areas without an ISO 3166-1 code of their own to
+// the code of the country they are usually attributed to.
+var breakawayCodes = map[string]string{
+	"CYN": "CY", // Northern Cyprus
+	"KOS": "XK", // Kosovo, user-assigned code
+	"SOL": "SO", // Somaliland
+}
+
+type feature struct {
+	Properties map[string]any `json:"properties"`
+	Geometry   struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+type boundary struct {
+	code     string
+	polygons [][][][2]float64
+}
+
+func main() {
+	input := flag.String("i", "ne_110m_admin_0_countries.geojson", "Natural Earth admin 0 countries GeoJSON")
+	precision := flag.Int("precision", 2, "decimals kept in coordinates")
+	output := flag.String("o", "boundaries.geojson", "output file")
+	flag.Parse()
+
+	data, err := os.ReadFile(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var collection struct {
+		Features []feature `json:"features"`
+	}
+	if err := json.Unmarshal(data, &collection); err != nil {
+		log.Fatalf("%s: %v", *input, err)
+	}
+
+	var boundaries []boundary
+	for i, f := range collection.Features {
+		code := countryCode(f.Properties)
+		if code == "" {
+			log.Printf("%s: feature %d (%v) has no country code, skipped", *input, i+1, f.Properties["name"])
+			continue
+		}
+		var polygons [][][][]float64
+		switch f.Geometry.Type {
+		case "Polygon":
+			var polygon [][][]float64
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygon)
+			polygons = [][][][]float64{polygon}
+		case "MultiPolygon":
+			err = json.Unmarshal(f.Geometry.Coordinates, &polygons)
+		default:
+			err = fmt.Errorf("unsupported geometry type %q", f.Geometry.Type)
+		}
+		if err != nil {
+			log.Fatalf("%s: feature %d (%s): %v", *input, i+1, code, err)
+		}
+		b := boundary{code: code}
+		for _, polygon := range polygons {
+			if rounded := roundPolygon(polygon, *precision); rounded != nil {
+				b.polygons = append(b.polygons, rounded)
+			}
+		}
+		if len(b.polygons) > 0 {
+			boundaries = append(boundaries, b)
+		}
+	}
+	sort.SliceStable(boundaries, func(i, j int) bool { return boundaries[i].code < boundaries[j].code })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{\"type\":\"FeatureCollection\",\n")
+	fmt.Fprintf(&buf, "\"source\":\"Natural Earth 1:110m admin 0 countries, public domain; generated by gen_boundaries.go\",\n")
+	fmt.Fprintf(&buf, "\"features\":[\n")
+	for i, b := range boundaries {
+		geometry := "MultiPolygon"
+		coordinates := formatPolygons(b.polygons)
+		if len(b.polygons) == 1 {
+			geometry = "Polygon"
+			coordinates = coordinates[1 : len(coordinates)-1]
+		}
+		fmt.Fprintf(&buf, "{\"type\":\"Feature\",\"properties\":{\"ISO_A2\":%q},\"geometry\":{\"type\":%q,\"coordinates\":%s}}",
+			b.code, geometry, coordinates)
+		if i < len(boundaries)-1 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("]}\n")
+	if !json.Valid(buf.Bytes()) {
+		log.Fatal("generated GeoJSON is not valid JSON")
+	}
+	if err := os.WriteFile(*output, buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// countryCode returns the alpha-2 country code of a Natural Earth feature, or an empty string.
+func countryCode(properties map[string]any) string {
+	for _, name := range codeProperties {
+		if code, ok := properties[name].(string); ok && len(code) == 2 {
+			return code
+		}
+	}
+	for _, name := range []string{"ADM0_A3", "adm0_a3"} {
+		if code, ok := properties[name].(string); ok && breakawayCodes[code] != "" {
+			return breakawayCodes[code]
+		}
+	}
+	return ""
+}
+
+// roundPolygon rounds the positions of a polygon, dropping repeated positions and the rings that
+// collapse. It returns nil if the outer ring collapses.
+func roundPolygon(polygon [][][]float64, precision int) [][][2]float64 {
+	scale := math.Pow(10, float64(precision))
+	var rings [][][2]float64
+	for i, positions := range polygon {
+		var ring [][2]float64
+		for _, position := range positions {
+			p := [2]float64{math.Round(position[0]*scale) / scale, math.Round(position[1]*scale) / scale}
+			if len(ring) == 0 || ring[len(ring)-1] != p {
+				ring = append(ring, p)
+			}
+		}
+		if len(ring) < 4 {
+			if i == 0 {
+				return nil
+			}
+			continue
+		}
+		rings = append(rings, ring)
+	}
+	return rings
+}
+
+// formatPolygons returns the GeoJSON coordinates of a MultiPolygon.
+func formatPolygons(polygons [][][][2]float64) string {
+	var b strings.Builder
+	b.WriteString("[")
+	for i, polygon := range polygons {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("[")
+		for j, ring := range polygon {
+			if j > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString("[")
+			for k, p := range ring {
+				if k > 0 {
+					b.WriteString(",")
+				}
+				b.WriteString("[" + strconv.FormatFloat(p[0], 'f', -1, 64) + "," + strconv.FormatFloat(p[1], 'f', -1, 64) + "]")
+			}
+			b.WriteString("]")
+		}
+		b.WriteString("]")
+	}
+	b.WriteString("]")
+	return b.String()
+}
@@ -0,0 +1,133 @@
+//go:build ignore
+
+// gen_timezones generates timezone_data.go from the zone.tab and iso3166.tab files of the
+// tz database.
+//
+// zone.tab is used rather than zone1970.tab because it names a zone for each country, such as
+// Europe/Stockholm for Sweden, where zone1970.tab lists Sweden under Europe/Berlin.
+//
+// Usage:
+//
+//	go run gen_timezones.go [-zoneinfo /usr/share/zoneinfo] [-o timezone_data.go]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	zoneinfo := flag.String("zoneinfo", "/usr/share/zoneinfo", "directory holding zone.tab and iso3166.tab")
+	output := flag.String("o", "timezone_data.go", "output file")
+	flag.Parse()
+
+	version, err := readVersion(filepath.Join(*zoneinfo, "tzdata.zi"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	zones, err := readTab(filepath.Join(*zoneinfo, "zone.tab"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	countries, err := readTab(filepath.Join(*zoneinfo, "iso3166.tab"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_timezones.go from tz database %s; DO NOT EDIT.\n\n", version)
+	fmt.Fprintf(&buf, "package countrycontinent\n\n")
+	fmt.Fprintf(&buf, "// tzCountryTimeZones holds the IANA time zones of each country in the order of zone.tab,\n")
+	fmt.Fprintf(&buf, "// keyed by ISO 3166-1 alpha-2 country code as used in the tz database.\n")
+	fmt.Fprintf(&buf, "var tzCountryTimeZones = map[string][]string{\n")
+	var codes []string
+	byCountry := make(map[string][]string)
+	for _, fields := range zones {
+		if len(fields) < 3 {
+			log.Fatalf("zone.tab: malformed line %q", strings.Join(fields, "\t"))
+		}
+		if _, ok := byCountry[fields[0]]; !ok {
+			codes = append(codes, fields[0])
+		}
+		byCountry[fields[0]] = append(byCountry[fields[0]], fields[2])
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		fmt.Fprintf(&buf, "\t%q: {%s},\n", code, quoteAll(byCountry[code]))
+	}
+	fmt.Fprintf(&buf, "}\n\n")
+
+	fmt.Fprintf(&buf, "// tzCountryCodes holds the ISO 3166-1 alpha-2 country codes listed in iso3166.tab.\n")
+	fmt.Fprintf(&buf, "var tzCountryCodes = []string{\n")
+	codes = codes[:0]
+	for _, fields := range countries {
+		codes = append(codes, fields[0])
+	}
+	sort.Strings(codes)
+	for i := 0; i < len(codes); i += 16 {
+		fmt.Fprintf(&buf, "\t%s,\n", quoteAll(codes[i:min(i+16, len(codes))]))
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readVersion returns the version of the tz database from the first line of tzdata.zi.
+func readVersion(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	line, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	version, ok := strings.CutPrefix(strings.TrimSpace(line), "# version ")
+	if !ok {
+		return "", fmt.Errorf("%s: no version line", name)
+	}
+	return version, nil
+}
+
+// readTab returns the tab-separated fields of each line of a tz database table, skipping comments.
+func readTab(name string) ([][]string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var lines [][]string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, strings.Split(line, "\t"))
+	}
+	return lines, scanner.Err()
+}
+
+// quoteAll returns the quoted strings separated by commas.
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,414 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// earthRadius is the mean radius of the Earth in kilometers.
+const earthRadius = 6371.0088
+
+// Coordinates is a geographic position in decimal degrees.
+type Coordinates struct {
+	Latitude  float64 // From -90 (south) to 90 (north)
+	Longitude float64 // From -180 (west) to 180 (east)
+}
+
+// isValid reports whether the latitude and longitude are within range.
+func (c Coordinates) isValid() bool {
+	return c.Latitude >= -90 && c.Latitude <= 90 && c.Longitude >= -180 && c.Longitude <= 180
+}
+
+// ErrInvalidCoordinates matches InvalidCoordinatesError with errors.Is.
+var ErrInvalidCoordinates = errors.New("invalid coordinates")
+
+// InvalidCoordinatesError is returned when a latitude or longitude is out of range.
+// It matches ErrInvalidCoordinates.
+type InvalidCoordinatesError struct {
+	Coordinates Coordinates
+}
+
+func (e *InvalidCoordinatesError) Error() string {
+	return fmt.Sprintf("invalid coordinates: %g, %g", e.Coordinates.Latitude, e.Coordinates.Longitude)
+}
+
+// Is reports whether target is ErrInvalidCoordinates.
+func (e *InvalidCoordinatesError) Is(target error) bool {
+	return target == ErrInvalidCoordinates
+}
+
+// ErrNoGeography matches NoGeographyError with errors.Is.
+var ErrNoGeography = errors.New("no geographic data for country")
+
+// NoGeographyError is returned when a country of the registry has no centroid, such as a country
+// added through a custom dataset. It matches ErrNoGeography.
+type NoGeographyError struct {
+	CountryCode string
+}
+
+func (e *NoGeographyError) Error() string {
+	return fmt.Sprintf("no geographic data for country: %s", e.CountryCode)
+}
+
+// Is reports whether target is ErrNoGeography.
+func (e *NoGeographyError) Is(target error) bool {
+	return target == ErrNoGeography
+}
+
+// Capital is the capital city of a country.
+type Capital struct {
+	Name           string      // Name of the city, empty if the country has no capital
+	Coordinates    Coordinates // Position of the city, meaningful only if HasCoordinates is set
+	HasCoordinates bool        // Whether the country has a capital and Coordinates holds its position
+}
+
+// NearbyCountry is a country returned by a nearest-countries query.
+type NearbyCountry struct {
+	CountryCode string  // ISO 3166-1 alpha-2 country code
+	CountryName string  // Full name of the country
+	Distance    float64 // Great-circle distance to the country's centroid, in kilometers
+}
+
+// geography holds the capital and representative centroid of a country.
+type geography struct {
+	capital  string
+	position Coordinates // Position of the capital
+	centroid Coordinates
+}
+
+// countryGeography holds the capital and centroid of each country, keyed by alpha-2 country code.
+// The United States Minor Outlying Islands have no capital; their centroid is Wake Island.
+var countryGeography = map[string]geography{
+	"AD": {"Andorra la Vella", Coordinates{42.5063, 1.5218}, Coordinates{42.5462, 1.6016}},
+	"AE": {"Abu Dhabi", Coordinates{24.4539, 54.3773}, Coordinates{23.4241, 53.8478}},
+	"AF": {"Kabul", Coordinates{34.5553, 69.2075}, Coordinates{33.9391, 67.71}},
+	"AG": {"Saint John's", Coordinates{17.1274, -61.8468}, Coordinates{17.0608, -61.7964}},
+	"AI": {"The Valley", Coordinates{18.217, -63.0578}, Coordinates{18.2206, -63.0686}},
+	"AL": {"Tirana", Coordinates{41.3275, 19.8187}, Coordinates{41.1533, 20.1683}},
+	"AM": {"Yerevan", Coordinates{40.1792, 44.4991}, Coordinates{40.0691, 45.0382}},
+	"AO": {"Luanda", Coordinates{-8.839, 13.2894}, Coordinates{-11.2027, 17.8739}},
+	"AR": {"Buenos Aires", Coordinates{-34.6037, -58.3816}, Coordinates{-38.4161, -63.6167}},
+	"AS": {"Pago Pago", Coordinates{-14.2756, -170.702}, Coordinates{-14.271, -170.1322}},
+	"AT": {"Vienna", Coordinates{48.2082, 16.3738}, Coordinates{47.5162, 14.5501}},
+	"AU": {"Canberra", Coordinates{-35.2809, 149.13}, Coordinates{-25.2744, 133.7751}},
+	"AW": {"Oranjestad", Coordinates{12.5092, -70.0086}, Coordinates{12.5211, -69.9683}},
+	"AZ": {"Baku", Coordinates{40.4093, 49.8671}, Coordinates{40.1431, 47.5769}},
+	"BA": {"Sarajevo", Coordinates{43.8563, 18.4131}, Coordinates{43.9159, 17.6791}},
+	"BB": {"Bridgetown", Coordinates{13.0975, -59.6167}, Coordinates{13.1939, -59.5432}},
+	"BD": {"Dhaka", Coordinates{23.8103, 90.4125}, Coordinates{23.685, 90.3563}},
+	"BE": {"Brussels", Coordinates{50.8503, 4.3517}, Coordinates{50.5039, 4.4699}},
+	"BF": {"Ouagadougou", Coordinates{12.3714, -1.5197}, Coordinates{12.2383, -1.5616}},
+	"BG": {"Sofia", Coordinates{42.6977, 23.3219}, Coordinates{42.7339, 25.4858}},
+	"BH": {"Manama", Coordinates{26.2285, 50.586}, Coordinates{25.9304, 50.6378}},
+	"BI": {"Gitega", Coordinates{-3.4271, 29.9246}, Coordinates{-3.3731, 29.9189}},
+	"BJ": {"Porto-Novo", Coordinates{6.4969, 2.6289}, Coordinates{9.3077, 2.3158}},
+	"BM": {"Hamilton", Coordinates{32.2949, -64.7814}, Coordinates{32.3214, -64.7574}},
+	"BN": {"Bandar Seri Begawan", Coordinates{4.9031, 114.9398}, Coordinates{4.5353, 114.7277}},
+	"BO": {"Sucre", Coordinates{-19.0196, -65.2619}, Coordinates{-16.2902, -63.5887}},
+	"BR": {"Brasília", Coordinates{-15.7939, -47.8828}, Coordinates{-14.235, -51.9253}},
+	"BS": {"Nassau", Coordinates{25.0443, -77.3504}, Coordinates{25.0343, -77.3963}},
+	"BT": {"Thimphu", Coordinates{27.4728, 89.639}, Coordinates{27.5142, 90.4336}},
+	"BW": {"Gaborone", Coordinates{-24.6282, 25.9231}, Coordinates{-22.3285, 24.6849}},
+	"BY": {"Minsk", Coordinates{53.9045, 27.5615}, Coordinates{53.7098, 27.9534}},
+	"BZ": {"Belmopan", Coordinates{17.251, -88.759}, Coordinates{17.1899, -88.4976}},
+	"CA": {"Ottawa", Coordinates{45.4215, -75.6972}, Coordinates{56.1304, -106.3468}},
+	"CC": {"West Island", Coordinates{-12.1568, 96.8225}, Coordinates{-12.1642, 96.871}},
+	"CD": {"Kinshasa", Coordinates{-4.4419, 15.2663}, Coordinates{-4.0383, 21.7587}},
+	"CF": {"Bangui", Coordinates{4.3947, 18.5582}, Coordinates{6.6111, 20.9394}},
+	"CH": {"Bern", Coordinates{46.948, 7.4474}, Coordinates{46.8182, 8.2275}},
+	"CI": {"Yamoussoukro", Coordinates{6.8276, -5.2893}, Coordinates{7.54, -5.5471}},
+	"CK": {"Avarua", Coordinates{-21.2075, -159.775}, Coordinates{-21.2367, -159.7777}},
+	"CL": {"Santiago", Coordinates{-33.4489, -70.6693}, Coordinates{-35.6751, -71.543}},
+	"CM": {"Yaoundé", Coordinates{3.848, 11.5021}, Coordinates{7.3697, 12.3547}},
+	"CN": {"Beijing", Coordinates{39.9042, 116.4074}, Coordinates{35.8617, 104.1954}},
+	"CO": {"Bogotá", Coordinates{4.711, -74.0721}, Coordinates{4.5709, -74.2973}},
+	"CR": {"San José", Coordinates{9.9281, -84.0907}, Coordinates{9.7489, -83.7534}},
+	"CU": {"Havana", Coordinates{23.1136, -82.3666}, Coordinates{21.5218, -77.7812}},
+	"CV": {"Praia", Coordinates{14.933, -23.5133}, Coordinates{16.0021, -24.0132}},
+	"CX": {"Flying Fish Cove", Coordinates{-10.4217, 105.6791}, Coordinates{-10.4475, 105.6904}},
+	"CY": {"Nicosia", Coordinates{35.1856, 33.3823}, Coordinates{35.1264, 33.4299}},
+	"CZ": {"Prague", Coordinates{50.0755, 14.4378}, Coordinates{49.8175, 15.473}},
+	"DE": {"Berlin", Coordinates{52.52, 13.405}, Coordinates{51.1657, 10.4515}},
+	"DJ": {"Djibouti", Coordinates{11.5721, 43.1456}, Coordinates{11.8251, 42.5903}},
+	"DK": {"Copenhagen", Coordinates{55.6761, 12.5683}, Coordinates{56.2639, 9.5018}},
+	"DM": {"Roseau", Coordinates{15.301, -61.387}, Coordinates{15.415, -61.371}},
+	"DO": {"Santo Domingo", Coordinates{18.4861, -69.9312}, Coordinates{18.7357, -70.1627}},
+	"DZ": {"Algiers", Coordinates{36.7538, 3.0588}, Coordinates{28.0339, 1.6596}},
+	"EC": {"Quito", Coordinates{-0.1807, -78.4678}, Coordinates{-1.8312, -78.1834}},
+	"EE": {"Tallinn", Coordinates{59.437, 24.7536}, Coordinates{58.5953, 25.0136}},
+	"EG": {"Cairo", Coordinates{30.0444, 31.2357}, Coordinates{26.8206, 30.8025}},
+	"EH": {"Laayoune", Coordinates{27.1253, -13.1625}, Coordinates{24.2155, -12.8858}},
+	"ER": {"Asmara", Coordinates{15.3229, 38.9251}, Coordinates{15.1794, 39.7823}},
+	"ES": {"Madrid", Coordinates{40.4168, -3.7038}, Coordinates{40.4637, -3.7492}},
+	"ET": {"Addis Ababa", Coordinates{8.9806, 38.7578}, Coordinates{9.145, 40.4897}},
+	"FI": {"Helsinki", Coordinates{60.1699, 24.9384}, Coordinates{61.9241, 25.7482}},
+	"FJ": {"Suva", Coordinates{-18.1248, 178.4501}, Coordinates{-16.5782, 179.4144}},
+	"FK": {"Stanley", Coordinates{-51.6977, -57.8517}, Coordinates{-51.7963, -59.5236}},
+	"FM": {"Palikir", Coordinates{6.9248, 158.161}, Coordinates{7.4256, 150.5508}},
+	"FO": {"Tórshavn", Coordinates{62.0079, -6.79}, Coordinates{61.8926, -6.9118}},
+	"FR": {"Paris", Coordinates{48.8566, 2.3522}, Coordinates{46.2276, 2.2137}},
+	"GA": {"Libreville", Coordinates{0.4162, 9.4673}, Coordinates{-0.8037, 11.6094}},
+	"GB": {"London", Coordinates{51.5074, -0.1278}, Coordinates{55.3781, -3.436}},
+	"GD": {"Saint George's", Coordinates{12.0561, -61.7488}, Coordinates{12.2628, -61.6042}},
+	"GE": {"Tbilisi", Coordinates{41.7151, 44.8271}, Coordinates{42.3154, 43.3569}},
+	"GF": {"Cayenne", Coordinates{4.9224, -52.3135}, Coordinates{3.9339, -53.1258}},
+	"GH": {"Accra", Coordinates{5.6037, -0.187}, Coordinates{7.9465, -1.0232}},
+	"GI": {"Gibraltar", Coordinates{36.1408, -5.3536}, Coordinates{36.1377, -5.3454}},
+	"GL": {"Nuuk", Coordinates{64.1814, -51.6941}, Coordinates{71.7069, -42.6043}},
+	"GM": {"Banjul", Coordinates{13.4549, -16.579}, Coordinates{13.4432, -15.3101}},
+	"GN": {"Conakry", Coordinates{9.6412, -13.5784}, Coordinates{9.9456, -9.6966}},
+	"GP": {"Basse-Terre", Coordinates{15.9985, -61.7255}, Coordinates{16.996, -62.0676}},
+	"GQ": {"Malabo", Coordinates{3.7504, 8.7371}, Coordinates{1.6508, 10.2679}},
+	"GR": {"Athens", Coordinates{37.9838, 23.7275}, Coordinates{39.0742, 21.8243}},
+	"GS": {"King Edward Point", Coordinates{-54.2833, -36.5}, Coordinates{-54.4296, -36.5879}},
+	"GT": {"Guatemala City", Coordinates{14.6349, -90.5069}, Coordinates{15.7835, -90.2308}},
+	"GU": {"Hagåtña", Coordinates{13.4757, 144.7489}, Coordinates{13.4443, 144.7937}},
+	"GW": {"Bissau", Coordinates{11.8817, -15.6178}, Coordinates{11.8037, -15.1804}},
+	"GY": {"Georgetown", Coordinates{6.8013, -58.1551}, Coordinates{4.8604, -58.9302}},
+	"HK": {"Hong Kong", Coordinates{22.2793, 114.1628}, Coordinates{22.3964, 114.1095}},
+	"HN": {"Tegucigalpa", Coordinates{14.0723, -87.1921}, Coordinates{15.2, -86.2419}},
+	"HR": {"Zagreb", Coordinates{45.815, 15.9819}, Coordinates{45.1, 15.2}},
+	"HT": {"Port-au-Prince", Coordinates{18.5944, -72.3074}, Coordinates{18.9712, -72.2852}},
+	"HU": {"Budapest", Coordinates{47.4979, 19.0402}, Coordinates{47.1625, 19.5033}},
+	"ID": {"Jakarta", Coordinates{-6.2088, 106.8456}, Coordinates{-0.7893, 113.9213}},
+	"IE": {"Dublin", Coordinates{53.3498, -6.2603}, Coordinates{53.4129, -8.2439}},
+	"IL": {"Jerusalem", Coordinates{31.7683, 35.2137}, Coordinates{31.0461, 34.8516}},
+	"IN": {"New Delhi", Coordinates{28.6139, 77.209}, Coordinates{20.5937, 78.9629}},
+	"IO": {"Diego Garcia", Coordinates{-7.3133, 72.4111}, Coordinates{-6.3432, 71.8765}},
+	"IQ": {"Baghdad", Coordinates{33.3152, 44.3661}, Coordinates{33.2232, 43.6793}},
+	"IR": {"Tehran", Coordinates{35.6892, 51.389}, Coordinates{32.4279, 53.688}},
+	"IS": {"Reykjavík", Coordinates{64.1466, -21.9426}, Coordinates{64.9631, -19.0208}},
+	"IT": {"Rome", Coordinates{41.9028, 12.4964}, Coordinates{41.8719, 12.5674}},
+	"JM": {"Kingston", Coordinates{17.9712, -76.7936}, Coordinates{18.1096, -77.2975}},
+	"JO": {"Amman", Coordinates{31.9454, 35.9284}, Coordinates{30.5852, 36.2384}},
+	"JP": {"Tokyo", Coordinates{35.6762, 139.6503}, Coordinates{36.2048, 138.2529}},
+	"KE": {"Nairobi", Coordinates{-1.2921, 36.8219}, Coordinates{-0.0236, 37.9062}},
+	"KG": {"Bishkek", Coordinates{42.8746, 74.5698}, Coordinates{41.2044, 74.7661}},
+	"KH": {"Phnom Penh", Coordinates{11.5564, 104.9282}, Coordinates{12.5657, 104.991}},
+	"KI": {"South Tarawa", Coordinates{1.329, 172.979}, Coordinates{-3.3704, -168.734}},
+	"KM": {"Moroni", Coordinates{-11.7172, 43.2473}, Coordinates{-11.875, 43.8722}},
+	"KN": {"Basseterre", Coordinates{17.3026, -62.7177}, Coordinates{17.3578, -62.783}},
+	"KP": {"Pyongyang", Coordinates{39.0392, 125.7625}, Coordinates{40.3399, 127.5101}},
+	"KR": {"Seoul", Coordinates{37.5665, 126.978}, Coordinates{35.9078, 127.7669}},
+	"KW": {"Kuwait City", Coordinates{29.3759, 47.9774}, Coordinates{29.3117, 47.4818}},
+	"KY": {"George Town", Coordinates{19.2869, -81.3674}, Coordinates{19.5135, -80.567}},
+	"KZ": {"Astana", Coordinates{51.1605, 71.4704}, Coordinates{48.0196, 66.9237}},
+	"LA": {"Vientiane", Coordinates{17.9757, 102.6331}, Coordinates{19.8563, 102.4955}},
+	"LB": {"Beirut", Coordinates{33.8938, 35.5018}, Coordinates{33.8547, 35.8623}},
+	"LC": {"Castries", Coordinates{14.0101, -60.9875}, Coordinates{13.9094, -60.9789}},
+	"LI": {"Vaduz", Coordinates{47.141, 9.5209}, Coordinates{47.166, 9.5554}},
+	"LK": {"Sri Jayawardenepura Kotte", Coordinates{6.8868, 79.9187}, Coordinates{7.8731, 80.7718}},
+	"LR": {"Monrovia", Coordinates{6.3156, -10.8074}, Coordinates{6.4281, -9.4295}},
+	"LS": {"Maseru", Coordinates{-29.3151, 27.4869}, Coordinates{-29.61, 28.2336}},
+	"LT": {"Vilnius", Coordinates{54.6872, 25.2797}, Coordinates{55.1694, 23.8813}},
+	"LU": {"Luxembourg", Coordinates{49.6116, 6.1319}, Coordinates{49.8153, 6.1296}},
+	"LV": {"Riga", Coordinates{56.9496, 24.1052}, Coordinates{56.8796, 24.6032}},
+	"LY": {"Tripoli", Coordinates{32.8872, 13.1913}, Coordinates{26.3351, 17.2283}},
+	"MA": {"Rabat", Coordinates{34.0209, -6.8416}, Coordinates{31.7917, -7.0926}},
+	"MC": {"Monaco", Coordinates{43.7384, 7.4246}, Coordinates{43.7503, 7.4128}},
+	"MD": {"Chișinău", Coordinates{47.0105, 28.8638}, Coordinates{47.4116, 28.3699}},
+	"MG": {"Antananarivo", Coordinates{-18.8792, 47.5079}, Coordinates{-18.7669, 46.8691}},
+	"MH": {"Majuro", Coordinates{7.1164, 171.1858}, Coordinates{7.1315, 171.1845}},
+	"MK": {"Skopje", Coordinates{41.9981, 21.4254}, Coordinates{41.6086, 21.7453}},
+	"ML": {"Bamako", Coordinates{12.6392, -8.0029}, Coordinates{17.5707, -3.9962}},
+	"MM": {"Naypyidaw", Coordinates{19.7633, 96.0785}, Coordinates{21.914, 95.9562}},
+	"MN": {"Ulaanbaatar", Coordinates{47.8864, 106.9057}, Coordinates{46.8625, 103.8467}},
+	"MO": {"Macau", Coordinates{22.1987, 113.5439}, Coordinates{22.1987, 113.5439}},
+	"MP": {"Saipan", Coordinates{15.1778, 145.751}, Coordinates{17.3308, 145.3847}},
+	"MQ": {"Fort-de-France", Coordinates{14.6161, -61.0588}, Coordinates{14.6415, -61.0242}},
+	"MR": {"Nouakchott", Coordinates{18.0735, -15.9582}, Coordinates{21.0079, -10.9408}},
+	"MS": {"Brades", Coordinates{16.7918, -62.2106}, Coordinates{16.7425, -62.1874}},
+	"MT": {"Valletta", Coordinates{35.8989, 14.5146}, Coordinates{35.9375, 14.3754}},
+	"MU": {"Port Louis", Coordinates{-20.1609, 57.5012}, Coordinates{-20.3484, 57.5522}},
+	"MV": {"Malé", Coordinates{4.1755, 73.5093}, Coordinates{3.2028, 73.2207}},
+	"MW": {"Lilongwe", Coordinates{-13.9626, 33.7741}, Coordinates{-13.2543, 34.3015}},
+	"MX": {"Mexico City", Coordinates{19.4326, -99.1332}, Coordinates{23.6345, -102.5528}},
+	"MY": {"Kuala Lumpur", Coordinates{3.139, 101.6869}, Coordinates{4.2105, 101.9758}},
+	"MZ": {"Maputo", Coordinates{-25.9692, 32.5732}, Coordinates{-18.6657, 35.5296}},
+	"NA": {"Windhoek", Coordinates{-22.5609, 17.0658}, Coordinates{-22.9576, 18.4904}},
+	"NC": {"Nouméa", Coordinates{-22.2558, 166.4505}, Coordinates{-20.9043, 165.618}},
+	"NE": {"Niamey", Coordinates{13.5116, 2.1254}, Coordinates{17.6078, 8.0817}},
+	"NF": {"Kingston", Coordinates{-29.0569, 167.9617}, Coordinates{-29.0408, 167.9547}},
+	"NG": {"Abuja", Coordinates{9.0765, 7.3986}, Coordinates{9.082, 8.6753}},
+	"NI": {"Managua", Coordinates{12.115, -86.2362}, Coordinates{12.8654, -85.2072}},
+	"NL": {"Amsterdam", Coordinates{52.3676, 4.9041}, Coordinates{52.1326, 5.2913}},
+	"NO": {"Oslo", Coordinates{59.9139, 10.7522}, Coordinates{60.472, 8.4689}},
+	"NP": {"Kathmandu", Coordinates{27.7172, 85.324}, Coordinates{28.3949, 84.124}},
+	"NR": {"Yaren", Coordinates{-0.5477, 166.9209}, Coordinates{-0.5228, 166.9315}},
+	"NU": {"Alofi", Coordinates{-19.0595, -169.9187}, Coordinates{-19.0544, -169.8672}},
+	"NZ": {"Wellington", Coordinates{-41.2865, 174.7762}, Coordinates{-40.9006, 174.886}},
+	"OM": {"Muscat", Coordinates{23.588, 58.3829}, Coordinates{21.5126, 55.9233}},
+	"PA": {"Panama City", Coordinates{8.9824, -79.5199}, Coordinates{8.538, -80.7821}},
+	"PE": {"Lima", Coordinates{-12.0464, -77.0428}, Coordinates{-9.19, -75.0152}},
+	"PF": {"Papeete", Coordinates{-17.5516, -149.5585}, Coordinates{-17.6797, -149.4068}},
+	"PG": {"Port Moresby", Coordinates{-9.4438, 147.1803}, Coordinates{-6.315, 143.9555}},
+	"PH": {"Manila", Coordinates{14.5995, 120.9842}, Coordinates{12.8797, 121.774}},
+	"PK": {"Islamabad", Coordinates{33.6844, 73.0479}, Coordinates{30.3753, 69.3451}},
+	"PL": {"Warsaw", Coordinates{52.2297, 21.0122}, Coordinates{51.9194, 19.1451}},
+	"PM": {"Saint-Pierre", Coordinates{46.7811, -56.1764}, Coordinates{46.9419, -56.2711}},
+	"PN": {"Adamstown", Coordinates{-25.066, -130.1015}, Coordinates{-24.7036, -127.4393}},
+	"PR": {"San Juan", Coordinates{18.4655, -66.1057}, Coordinates{18.2208, -66.5901}},
+	"PT": {"Lisbon", Coordinates{38.7223, -9.1393}, Coordinates{39.3999, -8.2245}},
+	"PW": {"Ngerulmud", Coordinates{7.5006, 134.6242}, Coordinates{7.515, 134.5825}},
+	"PY": {"Asunción", Coordinates{-25.2637, -57.5759}, Coordinates{-23.4425, -58.4438}},
+	"QA": {"Doha", Coordinates{25.2854, 51.531}, Coordinates{25.3548, 51.1839}},
+	"RE": {"Saint-Denis", Coordinates{-20.8823, 55.4504}, Coordinates{-21.1151, 55.5364}},
+	"RO": {"Bucharest", Coordinates{44.4268, 26.1025}, Coordinates{45.9432, 24.9668}},
+	"RU": {"Moscow", Coordinates{55.7558, 37.6173}, Coordinates{61.524, 105.3188}},
+	"RW": {"Kigali", Coordinates{-1.9441, 30.0619}, Coordinates{-1.9403, 29.8739}},
+	"SA": {"Riyadh", Coordinates{24.7136, 46.6753}, Coordinates{23.8859, 45.0792}},
+	"SB": {"Honiara", Coordinates{-9.4456, 159.9729}, Coordinates{-9.6457, 160.1562}},
+	"SC": {"Victoria", Coordinates{-4.6191, 55.4513}, Coordinates{-4.6796, 55.492}},
+	"SD": {"Khartoum", Coordinates{15.5007, 32.5599}, Coordinates{12.8628, 30.2176}},
+	"SE": {"Stockholm", Coordinates{59.3293, 18.0686}, Coordinates{60.1282, 18.6435}},
+	"SG": {"Singapore", Coordinates{1.3521, 103.8198}, Coordinates{1.3521, 103.8198}},
+	"SH": {"Jamestown", Coordinates{-15.9244, -5.7181}, Coordinates{-15.965, -5.7089}},
+	"SI": {"Ljubljana", Coordinates{46.0569, 14.5058}, Coordinates{46.1512, 14.9955}},
+	"SJ": {"Longyearbyen", Coordinates{78.2232, 15.6267}, Coordinates{77.5536, 23.6703}},
+	"SK": {"Bratislava", Coordinates{48.1486, 17.1077}, Coordinates{48.669, 19.699}},
+	"SL": {"Freetown", Coordinates{8.4657, -13.2317}, Coordinates{8.4606, -11.7799}},
+	"SM": {"San Marino", Coordinates{43.9424, 12.4578}, Coordinates{43.9424, 12.4578}},
+	"SN": {"Dakar", Coordinates{14.7167, -17.4677}, Coordinates{14.4974, -14.4524}},
+	"SO": {"Mogadishu", Coordinates{2.0469, 45.3182}, Coordinates{5.1521, 46.1996}},
+	"SR": {"Paramaribo", Coordinates{5.852, -55.2038}, Coordinates{3.9193, -56.0278}},
+	"ST": {"São Tomé", Coordinates{0.3302, 6.7333}, Coordinates{0.1864, 6.6131}},
+	"SV": {"San Salvador", Coordinates{13.6929, -89.2182}, Coordinates{13.7942, -88.8965}},
+	"SY": {"Damascus", Coordinates{33.5138, 36.2765}, Coordinates{34.8021, 38.9968}},
+	"SZ": {"Mbabane", Coordinates{-26.3054, 31.1367}, Coordinates{-26.5225, 31.4659}},
+	"TC": {"Cockburn Town", Coordinates{21.4612, -71.1419}, Coordinates{21.694, -71.7979}},
+	"TD": {"N'Djamena", Coordinates{12.1348, 15.0557}, Coordinates{15.4542, 18.7322}},
+	"TF": {"Port-aux-Français", Coordinates{-49.35, 70.2167}, Coordinates{-49.2804, 69.3486}},
+	"TG": {"Lomé", Coordinates{6.1256, 1.2254}, Coordinates{8.6195, 0.8248}},
+	"TH": {"Bangkok", Coordinates{13.7563, 100.5018}, Coordinates{15.87, 100.9925}},
+	"TJ": {"Dushanbe", Coordinates{38.5598, 68.787}, Coordinates{38.861, 71.2761}},
+	"TK": {"Nukunonu", Coordinates{-9.2005, -171.8484}, Coordinates{-8.9674, -171.8559}},
+	"TM": {"Ashgabat", Coordinates{37.9601, 58.3261}, Coordinates{38.9697, 59.5563}},
+	"TN": {"Tunis", Coordinates{36.8065, 10.1815}, Coordinates{33.8869, 9.5375}},
+	"TO": {"Nuku'alofa", Coordinates{-21.1394, -175.2049}, Coordinates{-21.179, -175.1982}},
+	"TP": {"Dili", Coordinates{-8.5569, 125.5603}, Coordinates{-8.8742, 125.7275}},
+	"TR": {"Ankara", Coordinates{39.9334, 32.8597}, Coordinates{38.9637, 35.2433}},
+	"TT": {"Port of Spain", Coordinates{10.6549, -61.5019}, Coordinates{10.6918, -61.2225}},
+	"TV": {"Funafuti", Coordinates{-8.5211, 179.1983}, Coordinates{-7.1095, 177.6493}},
+	"TW": {"Taipei", Coordinates{25.033, 121.5654}, Coordinates{23.6978, 120.9605}},
+	"TZ": {"Dodoma", Coordinates{-6.163, 35.7516}, Coordinates{-6.369, 34.8888}},
+	"UA": {"Kyiv", Coordinates{50.4501, 30.5234}, Coordinates{48.3794, 31.1656}},
+	"UG": {"Kampala", Coordinates{0.3476, 32.5825}, Coordinates{1.3733, 32.2903}},
+	"UM": {"", Coordinates{}, Coordinates{19.2823, 166.647}},
+	"US": {"Washington, D.C.", Coordinates{38.9072, -77.0369}, Coordinates{37.0902, -95.7129}},
+	"UY": {"Montevideo", Coordinates{-34.9011, -56.1645}, Coordinates{-32.5228, -55.7658}},
+	"UZ": {"Tashkent", Coordinates{41.2995, 69.2401}, Coordinates{41.3775, 64.5853}},
+	"VA": {"Vatican City", Coordinates{41.9029, 12.4534}, Coordinates{41.9029, 12.4534}},
+	"VC": {"Kingstown", Coordinates{13.16, -61.2248}, Coordinates{12.9843, -61.2872}},
+	"VE": {"Caracas", Coordinates{10.4806, -66.9036}, Coordinates{6.4238, -66.5897}},
+	"VG": {"Road Town", Coordinates{18.4286, -64.6185}, Coordinates{18.4207, -64.64}},
+	"VI": {"Charlotte Amalie", Coordinates{18.3419, -64.9307}, Coordinates{18.3358, -64.8963}},
+	"VN": {"Hanoi", Coordinates{21.0278, 105.8342}, Coordinates{14.0583, 108.2772}},
+	"VU": {"Port Vila", Coordinates{-17.7334, 168.3273}, Coordinates{-15.3767, 166.9592}},
+	"WF": {"Mata-Utu", Coordinates{-13.2825, -176.1745}, Coordinates{-13.7688, -177.1561}},
+	"WS": {"Apia", Coordinates{-13.8506, -171.7513}, Coordinates{-13.759, -172.1046}},
+	"YE": {"Sana'a", Coordinates{15.3694, 44.191}, Coordinates{15.5527, 48.5164}},
+	"YT": {"Mamoudzou", Coordinates{-12.7806, 45.2279}, Coordinates{-12.8275, 45.1662}},
+	"ZA": {"Pretoria", Coordinates{-25.7479, 28.2293}, Coordinates{-30.5595, 22.9375}},
+	"ZM": {"Lusaka", Coordinates{-15.3875, 28.3228}, Coordinates{-13.1339, 27.8493}},
+	"ZW": {"Harare", Coordinates{-17.8252, 31.0335}, Coordinates{-19.0154, 29.1549}},
+}
+
+// greatCircleDistance returns the distance between two positions along the surface of the Earth,
+// in kilometers, using the haversine formula.
+func greatCircleDistance(a, b Coordinates) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// CountryGetCapital returns the capital of a country and its coordinates.
+func (r *Registry) CountryGetCapital(countryCode string) (Capital, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return Capital{}, err
+	}
+	g := countryGeography[countryCode]
+	return Capital{Name: g.capital, Coordinates: g.position, HasCoordinates: g.capital != ""}, nil
+}
+
+// CountryGetCentroid returns a representative center point of a country. It returns a
+// *NoGeographyError for a country without geographic data.
+func (r *Registry) CountryGetCentroid(countryCode string) (Coordinates, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return Coordinates{}, err
+	}
+	g, ok := countryGeography[countryCode]
+	if !ok {
+		return Coordinates{}, &NoGeographyError{CountryCode: countryCode}
+	}
+	return g.centroid, nil
+}
+
+// CountryDistance returns the great-circle distance between the centroids of two countries, in kilometers.
+// It returns a *NoGeographyError if either country has no geographic data.
+func (r *Registry) CountryDistance(countryCode1, countryCode2 string) (float64, error) {
+	from, err := r.CountryGetCentroid(countryCode1)
+	if err != nil {
+		return 0, err
+	}
+	to, err := r.CountryGetCentroid(countryCode2)
+	if err != nil {
+		return 0, err
+	}
+	return greatCircleDistance(from, to), nil
+}
+
+// NearestCountries returns the countries whose centroids are closest to the given position,
+// nearest first. At most limit countries are returned; a limit of zero or less returns all of them.
+func (r *Registry) NearestCountries(latitude, longitude float64, limit int) ([]NearbyCountry, error) {
+	position := Coordinates{Latitude: latitude, Longitude: longitude}
+	if !position.isValid() {
+		return nil, &InvalidCoordinatesError{Coordinates: position}
+	}
+	var nearby []NearbyCountry
+	for _, country := range r.countries {
+		g, ok := countryGeography[country.CountryCode]
+		if !ok {
+			continue
+		}
+		nearby = append(nearby, NearbyCountry{
+			CountryCode: country.CountryCode,
+			CountryName: country.CountryName,
+			Distance:    greatCircleDistance(position, g.centroid),
+		})
+	}
+	sort.Slice(nearby, func(i, j int) bool {
+		if nearby[i].Distance != nearby[j].Distance {
+			return nearby[i].Distance < nearby[j].Distance
+		}
+		return nearby[i].CountryCode < nearby[j].CountryCode
+	})
+	if limit > 0 && len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby, nil
+}
+
+// CountryGetCapital returns the capital of a country and its coordinates.
+func CountryGetCapital(countryCode string) (Capital, error) {
+	return defaultRegistry.CountryGetCapital(countryCode)
+}
+
+// CountryGetCentroid returns a representative center point of a country.
+func CountryGetCentroid(countryCode string) (Coordinates, error) {
+	return defaultRegistry.CountryGetCentroid(countryCode)
+}
+
+// CountryDistance returns the great-circle distance between the centroids of two countries, in kilometers.
+func CountryDistance(countryCode1, countryCode2 string) (float64, error) {
+	return defaultRegistry.CountryDistance(countryCode1, countryCode2)
+}
+
+// NearestCountries returns the countries whose centroids are closest to the given position,
+// nearest first. At most limit countries are returned; a limit of zero or less returns all of them.
+func NearestCountries(latitude, longitude float64, limit int) ([]NearbyCountry, error) {
+	return defaultRegistry.NearestCountries(latitude, longitude, limit)
+}
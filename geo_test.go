@@ -0,0 +1,137 @@
+package countrycontinent
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCountryGetCapital(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: "Paris"},
+		{name: "Australia", code: "AU", want: "Canberra"},
+		{name: "No capital", code: "UM", want: ""},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetCapital(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetCapital(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if got.Name != tc.want {
+				t.Errorf("CountryGetCapital(%s) = %s; want %s", tc.code, got.Name, tc.want)
+			}
+			if got.HasCoordinates != (tc.want != "") {
+				t.Errorf("CountryGetCapital(%s).HasCoordinates = %t; want %t", tc.code, got.HasCoordinates, tc.want != "")
+			}
+		})
+	}
+}
+
+func TestCountryDistance(t *testing.T) {
+	tests := []struct {
+		name          string
+		code1, code2  string
+		min, max      float64
+		expectedError error
+	}{
+		{name: "Same country", code1: "FR", code2: "FR", min: 0, max: 0},
+		{name: "France to Germany", code1: "FR", code2: "DE", min: 700, max: 900},
+		{name: "Across the antimeridian", code1: "FJ", code2: "WS", min: 800, max: 1000},
+		{name: "France to New Zealand", code1: "FR", code2: "NZ", min: 18000, max: 20000},
+		{name: "Unknown code", code1: "FR", code2: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryDistance(tc.code1, tc.code2)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryDistance(%s, %s) error = %v, wantError %v", tc.code1, tc.code2, err, tc.expectedError)
+			}
+			if err == nil && (got < tc.min || got > tc.max) {
+				t.Errorf("CountryDistance(%s, %s) = %.0f; want between %.0f and %.0f", tc.code1, tc.code2, got, tc.min, tc.max)
+			}
+		})
+	}
+}
+
+func TestCountryWithoutGeography(t *testing.T) {
+	r, err := NewOverlay(AddCountry(CountryContinent{CountryCode: "XK", CountryName: "Kosovo", Continent: "Europe"}))
+	if err != nil {
+		t.Fatalf("NewOverlay() error = %v", err)
+	}
+	want := &NoGeographyError{CountryCode: "XK"}
+	if got, err := r.CountryGetCentroid("XK"); !sameError(err, want) {
+		t.Errorf("CountryGetCentroid(XK) = %v, %v; want %v", got, err, want)
+	}
+	if got, err := r.CountryDistance("XK", "FR"); !sameError(err, want) {
+		t.Errorf("CountryDistance(XK, FR) = %.1f, %v; want %v", got, err, want)
+	}
+	if got, err := r.CountryDistance("FR", "XK"); !errors.Is(err, ErrNoGeography) {
+		t.Errorf("CountryDistance(FR, XK) = %.1f, %v; want ErrNoGeography", got, err)
+	}
+	if capital, err := r.CountryGetCapital("XK"); err != nil || capital.HasCoordinates {
+		t.Errorf("CountryGetCapital(XK) = %v, %v; want no coordinates", capital, err)
+	}
+}
+
+func TestGreatCircleDistance(t *testing.T) {
+	paris := Coordinates{48.8566, 2.3522}
+	london := Coordinates{51.5074, -0.1278}
+	if got := greatCircleDistance(paris, london); math.Abs(got-343.6) > 1 {
+		t.Errorf("greatCircleDistance(Paris, London) = %.1f; want about 343.6", got)
+	}
+	poles := greatCircleDistance(Coordinates{90, 0}, Coordinates{-90, 0})
+	if math.Abs(poles-math.Pi*earthRadius) > 1e-6 {
+		t.Errorf("greatCircleDistance(North Pole, South Pole) = %f; want %f", poles, math.Pi*earthRadius)
+	}
+}
+
+func TestNearestCountries(t *testing.T) {
+	got, err := NearestCountries(46.5, 2.5, 3)
+	if err != nil {
+		t.Fatalf("NearestCountries() error = %v", err)
+	}
+	if len(got) != 3 || got[0].CountryCode != "FR" || got[0].CountryName != "France" {
+		t.Fatalf("NearestCountries(46.5, 2.5, 3) = %v; want France first", got)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Distance < got[i-1].Distance {
+			t.Errorf("NearestCountries() not sorted by distance: %v", got)
+		}
+	}
+	if all, _ := NearestCountries(0, 0, 0); len(all) != len(countryContinent) {
+		t.Errorf("NearestCountries(0, 0, 0) returned %d countries; want %d", len(all), len(countryContinent))
+	}
+
+	for _, c := range []Coordinates{{91, 0}, {0, -181}, {math.NaN(), 0}} {
+		if _, err := NearestCountries(c.Latitude, c.Longitude, 1); !sameError(err, &InvalidCoordinatesError{Coordinates: c}) {
+			t.Errorf("NearestCountries(%v) error = %v; want InvalidCoordinatesError", c, err)
+		}
+	}
+}
+
+func TestGeographyTableConsistent(t *testing.T) {
+	for _, c := range countryContinent {
+		g, ok := countryGeography[c.CountryCode]
+		if !ok {
+			t.Errorf("%s: no geography", c.CountryCode)
+			continue
+		}
+		if !g.centroid.isValid() || !g.position.isValid() {
+			t.Errorf("%s: coordinates out of range", c.CountryCode)
+		}
+		if g.capital != "" && greatCircleDistance(g.position, g.centroid) > 4000 {
+			t.Errorf("%s: capital %s is far from the centroid", c.CountryCode, g.capital)
+		}
+	}
+	if len(countryGeography) != len(countryContinent) {
+		t.Errorf("geography for %d countries; want %d", len(countryGeography), len(countryContinent))
+	}
+}
@@ -0,0 +1,410 @@
+package countrycontinent
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+)
+
+// ErrNoCountry matches NoCountryError with errors.Is.
+var ErrNoCountry = errors.New("no country at position")
+
+// NoCountryError is returned when a position lies outside every loaded country boundary,
+// such as at sea. It matches ErrNoCountry.
+type NoCountryError struct {
+	Coordinates Coordinates
+}
+
+func (e *NoCountryError) Error() string {
+	return fmt.Sprintf("no country at position: %g, %g", e.Coordinates.Latitude, e.Coordinates.Longitude)
+}
+
+// Is reports whether target is ErrNoCountry.
+func (e *NoCountryError) Is(target error) bool {
+	return target == ErrNoCountry
+}
+
+// BoundaryOptions controls the precision and size of loaded country boundaries.
+type BoundaryOptions struct {
+	// Tolerance is the distance, in degrees, a simplified boundary may stray from the source
+	// boundary. Zero keeps every vertex; larger values use less memory and answer faster, but
+	// misplace positions close to borders and drop islands smaller than the tolerance.
+	Tolerance float64
+
+	// CellSize is the size, in degrees, of the cells of the spatial index. Zero means 1 degree,
+	// and sizes below MinCellSize are raised to it: each polygon is indexed in every cell of its
+	// bounding box, so smaller cells would cost far more memory than they save in lookups.
+	CellSize float64
+
+	// CodeProperty names the feature property holding the country code. If empty, the properties
+	// ISO_A2_EH, ISO_A2, ISO3166-1-Alpha-2 and iso_a2 are tried in turn.
+	CodeProperty string
+}
+
+// MinCellSize is the smallest cell size of the spatial index, in degrees. It bounds the index to
+// 720 × 360 cells.
+const MinCellSize = 0.5
+
+// codeProperties are the feature properties holding the country code in common boundary datasets.
+var codeProperties = []string{"ISO_A2_EH", "ISO_A2", "ISO3166-1-Alpha-2", "iso_a2"}
+
+// Boundaries holds country boundaries and a spatial index to find the country at a position.
+// Boundaries are immutable once loaded and safe for concurrent use.
+type Boundaries struct {
+	polygons []boundaryPolygon
+	cellSize float64
+	grid     map[gridCell][]int
+}
+
+// boundaryPolygon is a polygon of a country boundary: an outer ring and optional holes.
+type boundaryPolygon struct {
+	countryCode string
+	rings       [][]Coordinates
+	min, max    Coordinates // Bounding box of the outer ring
+}
+
+// gridCell identifies a cell of the spatial index.
+type gridCell struct {
+	lat, lon int
+}
+
+// geoJSONFeatureCollection is the subset of a GeoJSON feature collection used to load boundaries.
+type geoJSONFeatureCollection struct {
+	Type     string `json:"type"`
+	Features []struct {
+		Properties map[string]any `json:"properties"`
+		Geometry   *struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"features"`
+}
+
+// LoadBoundaries loads country boundaries from a GeoJSON feature collection of Polygon and
+// MultiPolygon features, such as the Natural Earth admin 0 countries. Each feature's country
+// code is normalized with Normalize; features whose code is missing or not in the registry are
+// skipped. A *DatasetError lists every malformed feature.
+//
+// Boundaries are expected to follow the coastline, so positions at sea, including territorial
+// waters, lie in no country.
+func (r *Registry) LoadBoundaries(rd io.Reader, options BoundaryOptions) (*Boundaries, error) {
+	if !isFinite(options.CellSize) || !isFinite(options.Tolerance) {
+		return nil, fmt.Errorf("countrycontinent: invalid boundary options: cell size %g, tolerance %g", options.CellSize, options.Tolerance)
+	}
+	var collection geoJSONFeatureCollection
+	if err := json.NewDecoder(rd).Decode(&collection); err != nil {
+		return nil, err
+	}
+	if collection.Type != "FeatureCollection" {
+		return nil, fmt.Errorf("countrycontinent: GeoJSON type %q is not FeatureCollection", collection.Type)
+	}
+	if options.CellSize == 0 {
+		options.CellSize = 1
+	}
+	options.CellSize = max(options.CellSize, MinCellSize)
+
+	b := &Boundaries{cellSize: options.CellSize, grid: make(map[gridCell][]int)}
+	var problems []DatasetProblem
+	for i, feature := range collection.Features {
+		problem := func(format string, args ...any) {
+			problems = append(problems, DatasetProblem{Record: i + 1, Message: fmt.Sprintf(format, args...)})
+		}
+		countryCode, ok := r.featureCountryCode(feature.Properties, options.CodeProperty)
+		if !ok {
+			continue
+		}
+		if feature.Geometry == nil {
+			problem("%s: missing geometry", countryCode)
+			continue
+		}
+		var polygons [][][][]float64
+		var err error
+		switch feature.Geometry.Type {
+		case "Polygon":
+			var polygon [][][]float64
+			err = json.Unmarshal(feature.Geometry.Coordinates, &polygon)
+			polygons = [][][][]float64{polygon}
+		case "MultiPolygon":
+			err = json.Unmarshal(feature.Geometry.Coordinates, &polygons)
+		default:
+			problem("%s: unsupported geometry type %q", countryCode, feature.Geometry.Type)
+			continue
+		}
+		if err != nil {
+			problem("%s: malformed coordinates: %v", countryCode, err)
+			continue
+		}
+		for _, polygon := range polygons {
+			if err := b.addPolygon(countryCode, polygon, options.Tolerance); err != nil {
+				problem("%s: %v", countryCode, err)
+				break
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, &DatasetError{Problems: problems}
+	}
+	return b, nil
+}
+
+// LoadBoundariesFile loads country boundaries from a GeoJSON file in fsys.
+func (r *Registry) LoadBoundariesFile(fsys fs.FS, name string, options BoundaryOptions) (*Boundaries, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return r.LoadBoundaries(f, options)
+}
+
+//go:generate go run gen_boundaries.go
+
+// boundariesGeoJSON holds the country boundaries of the Natural Earth 1:110m admin 0 countries,
+// generated by gen_boundaries.go.
+//
+//go:embed boundaries.geojson
+var boundariesGeoJSON []byte
+
+// LoadEmbeddedBoundaries loads the embedded country boundaries, from the Natural Earth 1:110m admin 0
+// countries, with the given options. CountryAt uses them without simplification.
+func (r *Registry) LoadEmbeddedBoundaries(options BoundaryOptions) (*Boundaries, error) {
+	return r.LoadBoundaries(bytes.NewReader(boundariesGeoJSON), options)
+}
+
+// CountryAt returns the alpha-2 code of the country at a position, using the embedded boundaries,
+// which are loaded on first use. It returns a *NoCountryError at sea and in countries the embedded
+// boundaries do not draw at their scale, such as small islands, or that the registry does not hold.
+func (r *Registry) CountryAt(latitude, longitude float64) (string, error) {
+	b, err := r.boundaries()
+	if err != nil {
+		return "", err
+	}
+	return b.CountryAt(latitude, longitude)
+}
+
+// isFinite reports whether f is neither infinite nor NaN.
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
+
+// featureCountryCode returns the country code of a GeoJSON feature, if the registry holds it.
+func (r *Registry) featureCountryCode(properties map[string]any, property string) (string, bool) {
+	names := codeProperties
+	if property != "" {
+		names = []string{property}
+	}
+	for _, name := range names {
+		value, ok := properties[name].(string)
+		if !ok {
+			continue
+		}
+		if countryCode, err := r.Normalize(value); err == nil {
+			return countryCode, true
+		}
+	}
+	return "", false
+}
+
+// addPolygon simplifies a GeoJSON polygon, made of [longitude, latitude] positions, and adds it
+// to the boundaries and the spatial index. Rings that simplify away are dropped.
+func (b *Boundaries) addPolygon(countryCode string, polygon [][][]float64, tolerance float64) error {
+	var rings [][]Coordinates
+	for i, positions := range polygon {
+		ring := make([]Coordinates, len(positions))
+		for j, position := range positions {
+			if len(position) < 2 {
+				return fmt.Errorf("position %v has fewer than 2 coordinates", position)
+			}
+			ring[j] = Coordinates{Latitude: position[1], Longitude: position[0]}
+			if !ring[j].isValid() {
+				return fmt.Errorf("position %v is out of range", position)
+			}
+		}
+		if len(ring) < 4 || ring[0] != ring[len(ring)-1] {
+			return fmt.Errorf("ring %d is not a closed ring of at least 4 positions", i)
+		}
+		ring = simplifyRing(ring, tolerance)
+		if len(ring) < 4 {
+			if i == 0 {
+				return nil
+			}
+			continue
+		}
+		rings = append(rings, ring)
+	}
+	if len(rings) == 0 {
+		return nil
+	}
+
+	p := boundaryPolygon{countryCode: countryCode, rings: rings, min: rings[0][0], max: rings[0][0]}
+	for _, c := range rings[0] {
+		p.min.Latitude = math.Min(p.min.Latitude, c.Latitude)
+		p.min.Longitude = math.Min(p.min.Longitude, c.Longitude)
+		p.max.Latitude = math.Max(p.max.Latitude, c.Latitude)
+		p.max.Longitude = math.Max(p.max.Longitude, c.Longitude)
+	}
+	index := len(b.polygons)
+	b.polygons = append(b.polygons, p)
+	from, to := b.cellOf(p.min), b.cellOf(p.max)
+	for lat := from.lat; lat <= to.lat; lat++ {
+		for lon := from.lon; lon <= to.lon; lon++ {
+			cell := gridCell{lat: lat, lon: lon}
+			b.grid[cell] = append(b.grid[cell], index)
+		}
+	}
+	return nil
+}
+
+// cellOf returns the cell of the spatial index holding a position.
+func (b *Boundaries) cellOf(c Coordinates) gridCell {
+	return gridCell{
+		lat: int(math.Floor(c.Latitude / b.cellSize)),
+		lon: int(math.Floor(c.Longitude / b.cellSize)),
+	}
+}
+
+// CountryAt returns the alpha-2 code of the country whose boundary contains the position.
+// It returns a *NoCountryError if the position lies outside every boundary, as at sea.
+func (b *Boundaries) CountryAt(latitude, longitude float64) (string, error) {
+	position := Coordinates{Latitude: latitude, Longitude: longitude}
+	if !position.isValid() {
+		return "", &InvalidCoordinatesError{Coordinates: position}
+	}
+	for _, index := range b.grid[b.cellOf(position)] {
+		p := &b.polygons[index]
+		if latitude < p.min.Latitude || latitude > p.max.Latitude ||
+			longitude < p.min.Longitude || longitude > p.max.Longitude {
+			continue
+		}
+		if p.contains(position) {
+			return p.countryCode, nil
+		}
+	}
+	return "", &NoCountryError{Coordinates: position}
+}
+
+// Vertices returns the number of vertices held by the boundaries, a measure of their size.
+func (b *Boundaries) Vertices() int {
+	n := 0
+	for _, p := range b.polygons {
+		for _, ring := range p.rings {
+			n += len(ring)
+		}
+	}
+	return n
+}
+
+// contains reports whether the position lies inside the outer ring of the polygon and outside its holes.
+func (p *boundaryPolygon) contains(c Coordinates) bool {
+	if !ringContains(p.rings[0], c) {
+		return false
+	}
+	for _, hole := range p.rings[1:] {
+		if ringContains(hole, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// ringContains reports whether the position lies inside a closed ring, using the even-odd rule.
+func ringContains(ring []Coordinates, c Coordinates) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Latitude > c.Latitude) != (b.Latitude > c.Latitude) &&
+			c.Longitude < (b.Longitude-a.Longitude)*(c.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// simplifyRing simplifies a closed ring with the Douglas-Peucker algorithm, keeping the vertices
+// that stray more than tolerance degrees from the simplified outline.
+func simplifyRing(ring []Coordinates, tolerance float64) []Coordinates {
+	if tolerance <= 0 || len(ring) < 4 {
+		return ring
+	}
+	keep := make([]bool, len(ring))
+	keep[0], keep[len(ring)-1] = true, true
+	// A closed ring starts and ends on the same vertex, so split it at its farthest vertex.
+	far, farDistance := 0, -1.0
+	for i, c := range ring {
+		if d := math.Hypot(c.Latitude-ring[0].Latitude, c.Longitude-ring[0].Longitude); d > farDistance {
+			far, farDistance = i, d
+		}
+	}
+	keep[far] = true
+	simplifySection(ring, 0, far, tolerance, keep)
+	simplifySection(ring, far, len(ring)-1, tolerance, keep)
+
+	var simplified []Coordinates
+	for i, c := range ring {
+		if keep[i] {
+			simplified = append(simplified, c)
+		}
+	}
+	return simplified
+}
+
+// simplifySection marks the vertices of ring[from:to] to keep so that the outline strays at most
+// tolerance degrees from the section.
+func simplifySection(ring []Coordinates, from, to int, tolerance float64, keep []bool) {
+	if to-from < 2 {
+		return
+	}
+	farthest, farDistance := 0, -1.0
+	for i := from + 1; i < to; i++ {
+		if d := segmentDistance(ring[i], ring[from], ring[to]); d > farDistance {
+			farthest, farDistance = i, d
+		}
+	}
+	if farDistance <= tolerance {
+		return
+	}
+	keep[farthest] = true
+	simplifySection(ring, from, farthest, tolerance, keep)
+	simplifySection(ring, farthest, to, tolerance, keep)
+}
+
+// segmentDistance returns the planar distance in degrees from c to the segment between a and b.
+func segmentDistance(c, a, b Coordinates) float64 {
+	dLat, dLon := b.Latitude-a.Latitude, b.Longitude-a.Longitude
+	t := 0.0
+	if length := dLat*dLat + dLon*dLon; length > 0 {
+		t = ((c.Latitude-a.Latitude)*dLat + (c.Longitude-a.Longitude)*dLon) / length
+		t = math.Max(0, math.Min(1, t))
+	}
+	return math.Hypot(c.Latitude-(a.Latitude+t*dLat), c.Longitude-(a.Longitude+t*dLon))
+}
+
+// CountryAt returns the alpha-2 code of the country at a position, using the embedded Natural Earth
+// 1:110m boundaries, which are loaded on first use. It returns a *NoCountryError at sea.
+func CountryAt(latitude, longitude float64) (string, error) {
+	return defaultRegistry.CountryAt(latitude, longitude)
+}
+
+// LoadEmbeddedBoundaries loads the embedded Natural Earth 1:110m country boundaries with the given
+// options, keeping the countries of the embedded table.
+func LoadEmbeddedBoundaries(options BoundaryOptions) (*Boundaries, error) {
+	return defaultRegistry.LoadEmbeddedBoundaries(options)
+}
+
+// LoadBoundaries loads country boundaries from a GeoJSON feature collection of Polygon and
+// MultiPolygon features, such as the Natural Earth admin 0 countries, keeping the countries of
+// the embedded table.
+func LoadBoundaries(rd io.Reader, options BoundaryOptions) (*Boundaries, error) {
+	return defaultRegistry.LoadBoundaries(rd, options)
+}
+
+// LoadBoundariesFile loads country boundaries from a GeoJSON file in fsys, keeping the countries
+// of the embedded table.
+func LoadBoundariesFile(fsys fs.FS, name string, options BoundaryOptions) (*Boundaries, error) {
+	return defaultRegistry.LoadBoundariesFile(fsys, name, options)
+}
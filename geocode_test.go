@@ -0,0 +1,299 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// testBoundaries holds simple boundaries: France as a square, Switzerland as a square with a hole
+// standing for a lake, Timor-Leste under its ISO code as a MultiPolygon, and features that are skipped.
+const testBoundaries = `{
+  "type": "FeatureCollection",
+  "features": [
+    {"type": "Feature", "properties": {"ISO_A2": "FR"},
+     "geometry": {"type": "Polygon", "coordinates": [[[0, 43], [5, 43], [5, 49], [0, 49], [0, 43]]]}},
+    {"type": "Feature", "properties": {"ISO_A2": "CH"},
+     "geometry": {"type": "Polygon", "coordinates": [
+       [[6, 46], [10, 46], [10, 48], [6, 48], [6, 46]],
+       [[7, 46.5], [8, 46.5], [8, 47], [7, 47], [7, 46.5]]]}},
+    {"type": "Feature", "properties": {"ISO_A2": "TL"},
+     "geometry": {"type": "MultiPolygon", "coordinates": [
+       [[[124, -10], [127, -10], [127, -8], [124, -8], [124, -10]]],
+       [[[125, -8.5], [125.2, -8.5], [125.2, -8.2], [125, -8.2], [125, -8.5]]]]}},
+    {"type": "Feature", "properties": {"ISO_A2": "-99"},
+     "geometry": {"type": "Polygon", "coordinates": [[[30, 30], [31, 30], [31, 31], [30, 31], [30, 30]]]}},
+    {"type": "Feature", "properties": {"ISO_A2": "AQ"},
+     "geometry": {"type": "Polygon", "coordinates": [[[-180, -90], [180, -90], [180, -60], [-180, -60], [-180, -90]]]}}
+  ]
+}`
+
+func TestCountryAt(t *testing.T) {
+	b, err := LoadBoundaries(strings.NewReader(testBoundaries), BoundaryOptions{})
+	if err != nil {
+		t.Fatalf("LoadBoundaries() error = %v", err)
+	}
+	tests := []struct {
+		latitude, longitude float64
+		want                string
+		wantErr             error
+	}{
+		{latitude: 48.8566, longitude: 2.3522, want: "FR"},
+		{latitude: 47.5, longitude: 9, want: "CH"},
+		{latitude: 46.75, longitude: 7.5, wantErr: &NoCountryError{Coordinates: Coordinates{46.75, 7.5}}},
+		{latitude: -8.5569, longitude: 125.5603, want: "TP"},
+		{latitude: -8.3, longitude: 125.1, want: "TP"},
+		{latitude: 30.5, longitude: 30.5, wantErr: &NoCountryError{Coordinates: Coordinates{30.5, 30.5}}},
+		{latitude: -75, longitude: 0, wantErr: &NoCountryError{Coordinates: Coordinates{-75, 0}}},
+		{latitude: 45, longitude: -5, wantErr: &NoCountryError{Coordinates: Coordinates{45, -5}}},
+		{latitude: 91, longitude: 0, wantErr: &InvalidCoordinatesError{Coordinates: Coordinates{91, 0}}},
+	}
+	for _, tc := range tests {
+		got, err := b.CountryAt(tc.latitude, tc.longitude)
+		if got != tc.want || !sameError(err, tc.wantErr) {
+			t.Errorf("CountryAt(%g, %g) = %q, %v; want %q, %v", tc.latitude, tc.longitude, got, err, tc.want, tc.wantErr)
+		}
+	}
+	if _, err := b.CountryAt(0, -30); !errors.Is(err, ErrNoCountry) {
+		t.Errorf("CountryAt(0, -30) error = %v; want ErrNoCountry", err)
+	}
+}
+
+func TestLoadBoundariesFile(t *testing.T) {
+	fsys := fstest.MapFS{"countries.geojson": {Data: []byte(testBoundaries)}}
+	b, err := LoadBoundariesFile(fsys, "countries.geojson", BoundaryOptions{CellSize: 0.5})
+	if err != nil {
+		t.Fatalf("LoadBoundariesFile() error = %v", err)
+	}
+	if got, err := b.CountryAt(44, 1); got != "FR" || err != nil {
+		t.Errorf("CountryAt(44, 1) = %q, %v; want FR, nil", got, err)
+	}
+}
+
+func TestLoadBoundariesCellSize(t *testing.T) {
+	tests := []struct {
+		cellSize float64
+		want     float64
+	}{
+		{cellSize: 0, want: 1},
+		{cellSize: 0.01, want: MinCellSize},
+		{cellSize: -1, want: MinCellSize},
+		{cellSize: 5, want: 5},
+	}
+	for _, options := range []BoundaryOptions{{CellSize: math.NaN()}, {CellSize: math.Inf(1)}, {Tolerance: math.NaN()}} {
+		if _, err := LoadBoundaries(strings.NewReader(testBoundaries), options); err == nil {
+			t.Errorf("LoadBoundaries(%+v) error = nil; want an error", options)
+		}
+	}
+	for _, tc := range tests {
+		b, err := LoadBoundaries(strings.NewReader(testBoundaries), BoundaryOptions{CellSize: tc.cellSize})
+		if err != nil {
+			t.Fatalf("LoadBoundaries(CellSize: %g) error = %v", tc.cellSize, err)
+		}
+		if b.cellSize != tc.want {
+			t.Errorf("LoadBoundaries(CellSize: %g) cell size = %g; want %g", tc.cellSize, b.cellSize, tc.want)
+		}
+		if got, err := b.CountryAt(47.5, 9); got != "CH" || err != nil {
+			t.Errorf("CountryAt(47.5, 9) with CellSize %g = %q, %v; want CH, nil", tc.cellSize, got, err)
+		}
+	}
+	// France spans 5° × 6°, which the minimum cell size indexes in 10 × 12 cells.
+	b, _ := LoadBoundaries(strings.NewReader(testBoundaries), BoundaryOptions{CellSize: 0.001})
+	cells := 0
+	for _, indexes := range b.grid {
+		for _, i := range indexes {
+			if b.polygons[i].countryCode == "FR" {
+				cells++
+			}
+		}
+	}
+	if cells > 11*13 {
+		t.Errorf("France is indexed in %d cells; want at most %d", cells, 11*13)
+	}
+}
+
+func TestLoadBoundariesCodeProperty(t *testing.T) {
+	data := strings.ReplaceAll(testBoundaries, `"ISO_A2"`, `"code"`)
+	b, err := LoadBoundaries(strings.NewReader(data), BoundaryOptions{CodeProperty: "code"})
+	if err != nil {
+		t.Fatalf("LoadBoundaries() error = %v", err)
+	}
+	if got, err := b.CountryAt(47.5, 9); got != "CH" || err != nil {
+		t.Errorf("CountryAt(47.5, 9) = %q, %v; want CH, nil", got, err)
+	}
+}
+
+func TestLoadBoundariesTolerance(t *testing.T) {
+	// A circle of 360 vertices simplifies to a handful of vertices, and a small island disappears.
+	var ring strings.Builder
+	for i := 0; i <= 360; i++ {
+		if i > 0 {
+			ring.WriteString(", ")
+		}
+		angle := float64(i%360) * math.Pi / 180
+		fmt.Fprintf(&ring, "[%g, %g]", 20+5*math.Cos(angle), 5*math.Sin(angle))
+	}
+	data := `{"type": "FeatureCollection", "features": [
+	  {"properties": {"ISO_A2": "CD"}, "geometry": {"type": "Polygon", "coordinates": [[` + ring.String() + `]]}},
+	  {"properties": {"ISO_A2": "ST"}, "geometry": {"type": "Polygon", "coordinates": [[[6, 0], [6.01, 0], [6.01, 0.01], [6, 0.01], [6, 0]]]}}
+	]}`
+
+	full, err := LoadBoundaries(strings.NewReader(data), BoundaryOptions{})
+	if err != nil {
+		t.Fatalf("LoadBoundaries() error = %v", err)
+	}
+	simplified, err := LoadBoundaries(strings.NewReader(data), BoundaryOptions{Tolerance: 0.1})
+	if err != nil {
+		t.Fatalf("LoadBoundaries(Tolerance: 0.1) error = %v", err)
+	}
+	if full.Vertices() != 366 {
+		t.Errorf("Vertices() = %d; want 366", full.Vertices())
+	}
+	if n := simplified.Vertices(); n >= 100 {
+		t.Errorf("Vertices() with tolerance = %d; want fewer than 100", n)
+	}
+	for _, b := range []*Boundaries{full, simplified} {
+		if got, err := b.CountryAt(1, 21); got != "CD" || err != nil {
+			t.Errorf("CountryAt(1, 21) = %q, %v; want CD, nil", got, err)
+		}
+	}
+	if got, err := full.CountryAt(0.005, 6.005); got != "ST" || err != nil {
+		t.Errorf("CountryAt(0.005, 6.005) = %q, %v; want ST, nil", got, err)
+	}
+	if _, err := simplified.CountryAt(0.005, 6.005); !errors.Is(err, ErrNoCountry) {
+		t.Errorf("CountryAt(0.005, 6.005) with tolerance error = %v; want ErrNoCountry", err)
+	}
+}
+
+func TestLoadBoundariesErrors(t *testing.T) {
+	data := `{"type": "FeatureCollection", "features": [
+	  {"properties": {"ISO_A2": "FR"}, "geometry": {"type": "Point", "coordinates": [2, 48]}},
+	  {"properties": {"ISO_A2": "DE"}, "geometry": {"type": "Polygon", "coordinates": [[[6, 47], [15, 47], [15, 55]]]}},
+	  {"properties": {"ISO_A2": "-99"}, "geometry": {"type": "Point", "coordinates": [0, 0]}},
+	  {"properties": {"ISO_A2": "IT"}, "geometry": {"type": "Polygon", "coordinates": [[[6, 91], [18, 36], [18, 47], [6, 91]]]}},
+	  {"properties": {"ISO_A2": "ES"}}
+	]}`
+	_, err := LoadBoundaries(strings.NewReader(data), BoundaryOptions{})
+	var datasetErr *DatasetError
+	if !errors.As(err, &datasetErr) {
+		t.Fatalf("LoadBoundaries() error = %v; want *DatasetError", err)
+	}
+	var records []int
+	for _, p := range datasetErr.Problems {
+		records = append(records, p.Record)
+	}
+	if want := []int{1, 2, 4, 5}; !reflect.DeepEqual(records, want) {
+		t.Errorf("LoadBoundaries() problems in records %v; want %v", records, want)
+	}
+
+	if _, err := LoadBoundaries(strings.NewReader(`{"type": "Feature"}`), BoundaryOptions{}); err == nil {
+		t.Error("LoadBoundaries(Feature) error = nil; want an error")
+	}
+}
+
+func TestCountryAtEmbedded(t *testing.T) {
+	tests := []struct {
+		name                string
+		latitude, longitude float64
+		want                string
+		wantErr             error
+	}{
+		{name: "Paris", latitude: 48.8566, longitude: 2.3522, want: "FR"},
+		{name: "Berlin", latitude: 52.52, longitude: 13.405, want: "DE"},
+		{name: "Madrid", latitude: 40.4168, longitude: -3.7038, want: "ES"},
+		{name: "Brasilia", latitude: -15.7939, longitude: -47.8828, want: "BR"},
+		{name: "Ottawa", latitude: 45.4215, longitude: -75.6972, want: "CA"},
+		{name: "Nairobi", latitude: -1.2921, longitude: 36.8219, want: "KE"},
+		{name: "Canberra", latitude: -35.2809, longitude: 149.13, want: "AU"},
+		{name: "Chukotka east of the antimeridian", latitude: 66, longitude: -172, want: "RU"},
+		{name: "Timor-Leste under its former code", latitude: -8.8, longitude: 126, want: "TP"},
+		{name: "Atlantic Ocean", latitude: 0, longitude: -30, wantErr: &NoCountryError{Coordinates: Coordinates{0, -30}}},
+		{name: "Serbia, missing from the table", latitude: 44.8125, longitude: 20.4612, wantErr: &NoCountryError{Coordinates: Coordinates{44.8125, 20.4612}}},
+		{name: "Out of range", latitude: 0, longitude: 181, wantErr: &InvalidCoordinatesError{Coordinates: Coordinates{0, 181}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryAt(tc.latitude, tc.longitude)
+			if got != tc.want || !sameError(err, tc.wantErr) {
+				t.Errorf("CountryAt(%g, %g) = %q, %v; want %q, %v", tc.latitude, tc.longitude, got, err, tc.want, tc.wantErr)
+			}
+		})
+	}
+	if continent, err := CountryGetContinent(mustCountryAt(t, 35.6762, 139.6503)); continent != "Asia" || err != nil {
+		t.Errorf("continent of Tokyo = %s, %v; want Asia", continent, err)
+	}
+}
+
+func mustCountryAt(t *testing.T, latitude, longitude float64) string {
+	t.Helper()
+	code, err := CountryAt(latitude, longitude)
+	if err != nil {
+		t.Fatalf("CountryAt(%g, %g) error = %v", latitude, longitude, err)
+	}
+	return code
+}
+
+func TestCountryAtCustomRegistry(t *testing.T) {
+	r, err := NewOverlay(
+		AddCountry(CountryContinent{CountryCode: "RS", CountryName: "Serbia", Continent: "Europe"}),
+		AddCountry(CountryContinent{CountryCode: "XK", CountryName: "Kosovo", Continent: "Europe"}),
+		RemoveCountry("FR"),
+	)
+	if err != nil {
+		t.Fatalf("NewOverlay() error = %v", err)
+	}
+	tests := []struct {
+		latitude, longitude float64
+		want                string
+	}{
+		{latitude: 44.8125, longitude: 20.4612, want: "RS"},
+		{latitude: 42.6629, longitude: 21.1655, want: "XK"},
+		{latitude: 52.52, longitude: 13.405, want: "DE"},
+	}
+	for _, tc := range tests {
+		if got, err := r.CountryAt(tc.latitude, tc.longitude); got != tc.want || err != nil {
+			t.Errorf("CountryAt(%g, %g) = %q, %v; want %q, nil", tc.latitude, tc.longitude, got, err, tc.want)
+		}
+	}
+	if _, err := r.CountryAt(48.8566, 2.3522); !errors.Is(err, ErrNoCountry) {
+		t.Errorf("CountryAt(Paris) error = %v; want ErrNoCountry once FR is removed", err)
+	}
+}
+
+func TestEmbeddedBoundariesConsistent(t *testing.T) {
+	full, err := LoadEmbeddedBoundaries(BoundaryOptions{})
+	if err != nil {
+		t.Fatalf("LoadEmbeddedBoundaries() error = %v", err)
+	}
+	simplified, err := LoadEmbeddedBoundaries(BoundaryOptions{Tolerance: 0.5})
+	if err != nil {
+		t.Fatalf("LoadEmbeddedBoundaries(Tolerance: 0.5) error = %v", err)
+	}
+	if simplified.Vertices() >= full.Vertices()/2 {
+		t.Errorf("Vertices() with tolerance = %d; want less than half of %d", simplified.Vertices(), full.Vertices())
+	}
+
+	// Every country drawn in the embedded boundaries contains its own capital, except capitals on a
+	// coast or island the 1:110m scale does not follow.
+	coastal := map[string]bool{"BS": true, "DJ": true, "EH": true, "FK": true, "GQ": true, "IL": true, "LY": true, "SL": true, "UY": true, "VU": true}
+	drawn := make(map[string]bool)
+	for _, p := range full.polygons {
+		drawn[p.countryCode] = true
+	}
+	if len(drawn) < 160 {
+		t.Errorf("embedded boundaries draw %d countries of the table; want at least 160", len(drawn))
+	}
+	for code := range drawn {
+		g := countryGeography[code]
+		if g.capital == "" || coastal[code] {
+			continue
+		}
+		if got, err := full.CountryAt(g.position.Latitude, g.position.Longitude); got != code {
+			t.Errorf("CountryAt(capital of %s, %s) = %q, %v; want %s", code, g.capital, got, err, code)
+		}
+	}
+}
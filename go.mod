@@ -1,3 +1,3 @@
 module github.com/demoulin/countrycontinent/v1.5.1
 
-go 1.22.2
+go 1.23
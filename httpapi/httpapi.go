@@ -0,0 +1,190 @@
+// Package httpapi serves country and continent lookups as a JSON HTTP API.
+//
+// Endpoints:
+//
+//   - GET /countries
+//     Lists all countries, or those of a continent with ?continent=NAME.
+//
+//   - GET /countries/{code}
+//     Returns a country from its alpha-2, alpha-3 or numeric code.
+//
+//   - GET /continents
+//     Lists the continents.
+//
+//   - GET /continents/{name}/countries
+//     Lists the country codes of a continent.
+//
+// Malformed country codes are answered with 400 Bad Request and unknown countries or
+// continents with 404 Not Found, with suggestions of what the caller most likely meant.
+// Successful responses carry an ETag derived from the dataset version and a Cache-Control
+// header, and answer a matching If-None-Match with 304 Not Modified. Errors are not cached.
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/demoulin/countrycontinent/v1.5.1"
+)
+
+// DefaultMaxAge is the Cache-Control max-age of responses, in seconds.
+const DefaultMaxAge = 3600
+
+// Country is the JSON representation of a country.
+type Country struct {
+	CountryCode        string `json:"country_code"`
+	CountryName        string `json:"country_name"`
+	Continent          string `json:"continent"`
+	CountryCodeAlpha3  string `json:"alpha3,omitempty"`
+	CountryCodeNumeric string `json:"numeric,omitempty"`
+}
+
+// ContinentCountries is the JSON representation of the countries of a continent.
+type ContinentCountries struct {
+	Continent string   `json:"continent"`
+	Countries []string `json:"countries"`
+}
+
+// Error is the JSON representation of an error.
+type Error struct {
+	Error       string   `json:"error"`
+	Suggestions []string `json:"suggestions,omitempty"` // Codes or continents the caller most likely meant
+}
+
+// handler serves the API for a registry.
+type handler struct {
+	registry *countrycontinent.Registry
+	etag     string
+	mux      *http.ServeMux
+}
+
+// NewHandler returns an http.Handler serving the API for the given registry,
+// or for the embedded country table if r is nil.
+func NewHandler(r *countrycontinent.Registry) http.Handler {
+	if r == nil {
+		r = countrycontinent.DefaultRegistry()
+	}
+	h := &handler{
+		registry: r,
+		etag:     strconv.Quote(r.Version()),
+		mux:      http.NewServeMux(),
+	}
+	h.mux.HandleFunc("GET /countries", h.listCountries)
+	h.mux.HandleFunc("GET /countries/{code}", h.getCountry)
+	h.mux.HandleFunc("GET /continents", h.listContinents)
+	h.mux.HandleFunc("GET /continents/{name}/countries", h.getContinentCountries)
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	h.mux.ServeHTTP(w, req)
+}
+
+// writeOK writes body as a cacheable JSON response, or 304 Not Modified if the request's
+// If-None-Match header matches the ETag.
+func (h *handler) writeOK(w http.ResponseWriter, req *http.Request, body any) {
+	w.Header().Set("ETag", h.etag)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(DefaultMaxAge))
+	if h.etagMatches(req.Header.Get("If-None-Match")) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// etagMatches reports whether an If-None-Match header value, a list of entity tags or "*", matches
+// the ETag. Weak entity tags match by their opaque tag.
+func (h *handler) etagMatches(ifNoneMatch string) bool {
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == h.etag {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *handler) listCountries(w http.ResponseWriter, req *http.Request) {
+	countries := h.registry.Countries()
+	if name := req.URL.Query().Get("continent"); name != "" {
+		continent, err := countrycontinent.ParseContinent(name)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		filtered := countries[:0]
+		for _, country := range countries {
+			if country.Continent == continent.String() {
+				filtered = append(filtered, country)
+			}
+		}
+		countries = filtered
+	}
+	body := make([]Country, len(countries))
+	for i, country := range countries {
+		body[i] = Country(country)
+	}
+	h.writeOK(w, req, body)
+}
+
+func (h *handler) getCountry(w http.ResponseWriter, req *http.Request) {
+	country, err := h.registry.CountryLookup(req.PathValue("code"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	h.writeOK(w, req, Country(country))
+}
+
+func (h *handler) listContinents(w http.ResponseWriter, req *http.Request) {
+	var continents []string
+	for _, continent := range countrycontinent.AllContinents() {
+		if _, err := h.registry.ContinentGetCountriesTyped(continent); err == nil {
+			continents = append(continents, continent.String())
+		}
+	}
+	h.writeOK(w, req, continents)
+}
+
+func (h *handler) getContinentCountries(w http.ResponseWriter, req *http.Request) {
+	continent, err := countrycontinent.ParseContinent(req.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	countries, err := h.registry.ContinentGetCountriesTyped(continent)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	h.writeOK(w, req, ContinentCountries{Continent: continent.String(), Countries: countries})
+}
+
+// writeError writes err as JSON with the status code matching its type. Errors are not cached.
+func writeError(w http.ResponseWriter, err error) {
+	w.Header().Set("Cache-Control", "no-store")
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, countrycontinent.ErrInvalidCountryCode):
+		status = http.StatusBadRequest
+	case errors.Is(err, countrycontinent.ErrCountryNotFound), errors.Is(err, countrycontinent.ErrContinentNotFound):
+		status = http.StatusNotFound
+	}
+	body := Error{Error: err.Error()}
+	var suggester interface{ Suggestions() []string }
+	if errors.As(err, &suggester) {
+		body.Suggestions = suggester.Suggestions()
+	}
+	writeJSON(w, status, body)
+}
+
+// writeJSON writes body as JSON with the given status code.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	// The status is already sent, so an encoding error, such as a closed connection, cannot be reported.
+	_ = json.NewEncoder(w).Encode(body)
+}
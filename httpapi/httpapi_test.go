@@ -0,0 +1,146 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/demoulin/countrycontinent/v1.5.1"
+)
+
+func TestHandler(t *testing.T) {
+	h := NewHandler(nil)
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "Country by alpha-2", path: "/countries/FR", wantStatus: http.StatusOK, wantBody: `"country_name":"France"`},
+		{name: "Country by alpha-3", path: "/countries/MTQ", wantStatus: http.StatusOK, wantBody: `"country_code":"MQ"`},
+		{name: "Invalid country code", path: "/countries/fr", wantStatus: http.StatusBadRequest, wantBody: `"error":"invalid country code format: fr"`},
+		{name: "Unknown country code", path: "/countries/XX", wantStatus: http.StatusNotFound, wantBody: `"error":"country code not found: XX"`},
+		{name: "Continent countries", path: "/continents/North%20America/countries", wantStatus: http.StatusOK, wantBody: `{"continent":"North America","countries":["CA","GL","MX","PM","US"]}`},
+		{name: "Continent by code", path: "/continents/na/countries", wantStatus: http.StatusOK, wantBody: `"continent":"North America"`},
+		{name: "Unknown continent", path: "/continents/Atlantis/countries", wantStatus: http.StatusNotFound, wantBody: `"error":"continent not found: Atlantis"`},
+		{name: "Suggested country", path: "/countries/UK", wantStatus: http.StatusNotFound, wantBody: `"suggestions":["GB",`},
+		{name: "Suggested continent", path: "/continents/Oceana/countries", wantStatus: http.StatusNotFound, wantBody: `{"error":"continent not found: Oceana","suggestions":["Oceania"]}`},
+		{name: "Countries of a continent", path: "/countries?continent=Antarctica", wantStatus: http.StatusOK, wantBody: `[{"country_code":"TF","country_name":"French Southern Territories","continent":"Antarctica","alpha3":"ATF","numeric":"260"}]`},
+		{name: "Countries of unknown continent", path: "/countries?continent=Atlantis", wantStatus: http.StatusNotFound, wantBody: `"error"`},
+		{name: "Country code as continent", path: "/countries?continent=CA", wantStatus: http.StatusNotFound, wantBody: `"error":"continent not found: CA"`},
+		{name: "All countries", path: "/countries", wantStatus: http.StatusOK, wantBody: `"country_code":"ZW"`},
+		{name: "Continents", path: "/continents", wantStatus: http.StatusOK, wantBody: `"Antarctica","Asia","Caribbean"`},
+		{name: "Unknown path", path: "/planets", wantStatus: http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			if rec.Code != tc.wantStatus {
+				t.Errorf("GET %s status = %d, want %d", tc.path, rec.Code, tc.wantStatus)
+			}
+			if !strings.Contains(rec.Body.String(), tc.wantBody) {
+				t.Errorf("GET %s body = %s, want it to contain %s", tc.path, rec.Body.String(), tc.wantBody)
+			}
+			if tc.wantBody != "" && rec.Header().Get("Content-Type") != "application/json" {
+				t.Errorf("GET %s Content-Type = %s, want application/json", tc.path, rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestHandlerCaching(t *testing.T) {
+	h := NewHandler(nil)
+	etag := strconv.Quote(countrycontinent.DefaultRegistry().Version())
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/countries/FR", nil))
+	if got := rec.Header().Get("ETag"); got != etag {
+		t.Errorf("ETag = %s, want %s", got, etag)
+	}
+	if got := rec.Header().Get("Cache-Control"); got != "public, max-age=3600" {
+		t.Errorf("Cache-Control = %s, want public, max-age=3600", got)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/countries/FR", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("conditional GET status = %d with %d bytes, want 304 with no body", rec.Code, rec.Body.Len())
+	}
+
+	req.Header.Set("If-None-Match", `"stale"`)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET with stale ETag status = %d, want 200", rec.Code)
+	}
+}
+
+func TestHandlerConditionalRequests(t *testing.T) {
+	h := NewHandler(nil)
+	etag := strconv.Quote(countrycontinent.DefaultRegistry().Version())
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		ifNoneMatch      string
+		wantStatus       int
+		wantCacheControl string
+	}{
+		{name: "Matching ETag", method: http.MethodGet, path: "/countries/FR", ifNoneMatch: etag, wantStatus: http.StatusNotModified, wantCacheControl: "public, max-age=3600"},
+		{name: "HEAD", method: http.MethodHead, path: "/continents", ifNoneMatch: etag, wantStatus: http.StatusNotModified, wantCacheControl: "public, max-age=3600"},
+		{name: "Weak ETag", method: http.MethodGet, path: "/countries/FR", ifNoneMatch: "W/" + etag, wantStatus: http.StatusNotModified, wantCacheControl: "public, max-age=3600"},
+		{name: "ETag list", method: http.MethodGet, path: "/countries/FR", ifNoneMatch: `"stale", ` + etag, wantStatus: http.StatusNotModified, wantCacheControl: "public, max-age=3600"},
+		{name: "Any ETag", method: http.MethodGet, path: "/continents", ifNoneMatch: "*", wantStatus: http.StatusNotModified, wantCacheControl: "public, max-age=3600"},
+		{name: "Unknown country", method: http.MethodGet, path: "/countries/XX", ifNoneMatch: etag, wantStatus: http.StatusNotFound, wantCacheControl: "no-store"},
+		{name: "Invalid country code", method: http.MethodGet, path: "/countries/x1", ifNoneMatch: etag, wantStatus: http.StatusBadRequest, wantCacheControl: "no-store"},
+		{name: "Unknown continent", method: http.MethodGet, path: "/countries?continent=Atlantis", ifNoneMatch: "*", wantStatus: http.StatusNotFound, wantCacheControl: "no-store"},
+		{name: "Method not allowed", method: http.MethodPost, path: "/countries", ifNoneMatch: etag, wantStatus: http.StatusMethodNotAllowed},
+		{name: "Unknown path", method: http.MethodGet, path: "/nope", ifNoneMatch: etag, wantStatus: http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req.Header.Set("If-None-Match", tc.ifNoneMatch)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Errorf("%s %s status = %d, want %d", tc.method, tc.path, rec.Code, tc.wantStatus)
+			}
+			if got := rec.Header().Get("Cache-Control"); got != tc.wantCacheControl {
+				t.Errorf("%s %s Cache-Control = %q, want %q", tc.method, tc.path, got, tc.wantCacheControl)
+			}
+		})
+	}
+}
+
+func TestHandlerCustomRegistry(t *testing.T) {
+	r, err := countrycontinent.NewRegistry([]countrycontinent.CountryContinent{
+		{CountryCode: "XK", CountryName: "Kosovo", Continent: "Europe"},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	h := NewHandler(r)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/countries/XK", nil))
+	var got Country
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil || got.CountryName != "Kosovo" {
+		t.Errorf("GET /countries/XK = %s, %v; want Kosovo", rec.Body.String(), err)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/continents", nil))
+	if strings.TrimSpace(rec.Body.String()) != `["Europe"]` {
+		t.Errorf("GET /continents = %s, want [\"Europe\"]", rec.Body.String())
+	}
+	if rec.Header().Get("ETag") != strconv.Quote(r.Version()) {
+		t.Errorf("ETag = %s, want the custom registry version", rec.Header().Get("ETag"))
+	}
+}
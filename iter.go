@@ -0,0 +1,59 @@
+package countrycontinent
+
+import "iter"
+
+// CountriesSeq returns an iterator over the countries of the registry, keyed by alpha-2 country code
+// and sorted by code.
+func (r *Registry) CountriesSeq() iter.Seq2[string, CountryContinent] {
+	return func(yield func(string, CountryContinent) bool) {
+		for _, code := range r.sortedCodes {
+			if !yield(code, r.countryMap[code]) {
+				return
+			}
+		}
+	}
+}
+
+// ContinentsSeq returns an iterator over the names of the continents that have at least one country
+// in the registry, sorted by name.
+func (r *Registry) ContinentsSeq() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, name := range r.continentNames() {
+			if !yield(name) {
+				return
+			}
+		}
+	}
+}
+
+// ContinentCountriesSeq returns an iterator over the countries in a continent, keyed by alpha-2
+// country code and sorted by code. It returns a *ContinentNotFoundError if no country is in the continent.
+func (r *Registry) ContinentCountriesSeq(continent string) (iter.Seq2[string, CountryContinent], error) {
+	codes, ok := r.continentMap[continent]
+	if !ok {
+		return nil, continentNotFound(continent, r.continentNames())
+	}
+	return func(yield func(string, CountryContinent) bool) {
+		for _, code := range codes {
+			if !yield(code, r.countryMap[code]) {
+				return
+			}
+		}
+	}, nil
+}
+
+// CountriesSeq returns an iterator over all countries, keyed by alpha-2 country code and sorted by code.
+func CountriesSeq() iter.Seq2[string, CountryContinent] {
+	return defaultRegistry.CountriesSeq()
+}
+
+// ContinentsSeq returns an iterator over the names of all continents, sorted by name.
+func ContinentsSeq() iter.Seq[string] {
+	return defaultRegistry.ContinentsSeq()
+}
+
+// ContinentCountriesSeq returns an iterator over the countries in a continent, keyed by alpha-2
+// country code and sorted by code. It returns a *ContinentNotFoundError if the continent is unknown.
+func ContinentCountriesSeq(continent string) (iter.Seq2[string, CountryContinent], error) {
+	return defaultRegistry.ContinentCountriesSeq(continent)
+}
@@ -0,0 +1,78 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCountriesSeq(t *testing.T) {
+	var codes []string
+	for code, country := range CountriesSeq() {
+		if country.CountryCode != code {
+			t.Errorf("CountriesSeq() yielded %s for %s", country.CountryCode, code)
+		}
+		codes = append(codes, code)
+	}
+	if len(codes) != len(countryContinent) {
+		t.Errorf("CountriesSeq() yielded %d countries; want %d", len(codes), len(countryContinent))
+	}
+	if !sort.StringsAreSorted(codes) {
+		t.Errorf("CountriesSeq() codes are not sorted: %v", codes)
+	}
+
+	var first []string
+	for code := range CountriesSeq() {
+		if len(first) == 3 {
+			break
+		}
+		first = append(first, code)
+	}
+	if !reflect.DeepEqual(first, codes[:3]) {
+		t.Errorf("CountriesSeq() stopped early = %v; want %v", first, codes[:3])
+	}
+}
+
+func TestContinentsSeq(t *testing.T) {
+	var got []string
+	for name := range ContinentsSeq() {
+		got = append(got, name)
+	}
+	want := []string{"Africa", "Antarctica", "Asia", "Caribbean", "Central America", "Europe", "North America", "Oceania", "South America"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ContinentsSeq() = %v; want %v", got, want)
+	}
+}
+
+func TestContinentCountriesSeq(t *testing.T) {
+	tests := []struct {
+		name          string
+		continent     string
+		want          []string
+		expectedError error
+	}{
+		{name: "North America", continent: "North America", want: []string{"CA", "GL", "MX", "PM", "US"}},
+		{name: "Unknown continent", continent: "Atlantis", expectedError: &ContinentNotFoundError{Continent: "Atlantis"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			seq, err := ContinentCountriesSeq(tc.continent)
+			if !sameError(err, tc.expectedError) {
+				t.Fatalf("ContinentCountriesSeq(%s) error = %v, wantError %v", tc.continent, err, tc.expectedError)
+			}
+			if err != nil {
+				return
+			}
+			var got []string
+			for code, country := range seq {
+				if country.Continent != tc.continent {
+					t.Errorf("ContinentCountriesSeq(%s) yielded %s in %s", tc.continent, code, country.Continent)
+				}
+				got = append(got, code)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ContinentCountriesSeq(%s) = %v; want %v", tc.continent, got, tc.want)
+			}
+		})
+	}
+}
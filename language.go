@@ -0,0 +1,322 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Language is a language identified by its ISO 639 codes.
+type Language struct {
+	Code  string // ISO 639-1 two-letter code, empty if the language has none
+	Code3 string // ISO 639-3 three-letter code
+	Name  string // Name of the language
+}
+
+// ErrLanguageNotFound matches LanguageNotFoundError with errors.Is.
+var ErrLanguageNotFound = errors.New("language not found")
+
+// LanguageNotFoundError is returned when an ISO 639 language code is not found.
+// It matches ErrLanguageNotFound.
+type LanguageNotFoundError struct {
+	Language string
+}
+
+func (e *LanguageNotFoundError) Error() string {
+	return fmt.Sprintf("language not found: %s", e.Language)
+}
+
+// Is reports whether target is ErrLanguageNotFound.
+func (e *LanguageNotFoundError) Is(target error) bool {
+	return target == ErrLanguageNotFound
+}
+
+// languages holds the languages used in the countries of the table, sorted by ISO 639-3 code.
+var languages = []Language{
+	{"aa", "aar", "Afar"},
+	{"af", "afr", "Afrikaans"},
+	{"am", "amh", "Amharic"},
+	{"ar", "ara", "Arabic"},
+	{"ay", "aym", "Aymara"},
+	{"az", "aze", "Azerbaijani"},
+	{"bm", "bam", "Bambara"},
+	{"be", "bel", "Belarusian"},
+	{"bn", "ben", "Bengali"},
+	{"bi", "bis", "Bislama"},
+	{"bs", "bos", "Bosnian"},
+	{"bg", "bul", "Bulgarian"},
+	{"", "cal", "Carolinian"},
+	{"ca", "cat", "Catalan"},
+	{"cs", "ces", "Czech"},
+	{"ch", "cha", "Chamorro"},
+	{"", "crs", "Seselwa Creole French"},
+	{"da", "dan", "Danish"},
+	{"de", "deu", "German"},
+	{"dv", "div", "Dhivehi"},
+	{"dz", "dzo", "Dzongkha"},
+	{"el", "ell", "Modern Greek"},
+	{"en", "eng", "English"},
+	{"et", "est", "Estonian"},
+	{"eu", "eus", "Basque"},
+	{"fo", "fao", "Faroese"},
+	{"fa", "fas", "Persian"},
+	{"fj", "fij", "Fijian"},
+	{"", "fil", "Filipino"},
+	{"fi", "fin", "Finnish"},
+	{"fr", "fra", "French"},
+	{"", "gil", "Gilbertese"},
+	{"ga", "gle", "Irish"},
+	{"gl", "glg", "Galician"},
+	{"gn", "grn", "Guarani"},
+	{"ht", "hat", "Haitian"},
+	{"ha", "hau", "Hausa"},
+	{"he", "heb", "Hebrew"},
+	{"", "hif", "Fiji Hindi"},
+	{"hi", "hin", "Hindi"},
+	{"ho", "hmo", "Hiri Motu"},
+	{"hr", "hrv", "Croatian"},
+	{"hu", "hun", "Hungarian"},
+	{"hy", "hye", "Armenian"},
+	{"id", "ind", "Indonesian"},
+	{"is", "isl", "Icelandic"},
+	{"it", "ita", "Italian"},
+	{"ja", "jpn", "Japanese"},
+	{"kl", "kal", "Kalaallisut"},
+	{"ka", "kat", "Georgian"},
+	{"kk", "kaz", "Kazakh"},
+	{"km", "khm", "Khmer"},
+	{"rw", "kin", "Kinyarwanda"},
+	{"ky", "kir", "Kirghiz"},
+	{"ko", "kor", "Korean"},
+	{"ku", "kur", "Kurdish"},
+	{"lo", "lao", "Lao"},
+	{"la", "lat", "Latin"},
+	{"lv", "lav", "Latvian"},
+	{"lt", "lit", "Lithuanian"},
+	{"lb", "ltz", "Luxembourgish"},
+	{"mh", "mah", "Marshallese"},
+	{"mk", "mkd", "Macedonian"},
+	{"mg", "mlg", "Malagasy"},
+	{"mt", "mlt", "Maltese"},
+	{"mn", "mon", "Mongolian"},
+	{"mi", "mri", "Maori"},
+	{"ms", "msa", "Malay"},
+	{"my", "mya", "Burmese"},
+	{"na", "nau", "Nauru"},
+	{"nd", "nde", "North Ndebele"},
+	{"ne", "nep", "Nepali"},
+	{"", "niu", "Niuean"},
+	{"nl", "nld", "Dutch"},
+	{"no", "nor", "Norwegian"},
+	{"ny", "nya", "Nyanja"},
+	{"om", "orm", "Oromo"},
+	{"", "pap", "Papiamento"},
+	{"", "pau", "Palauan"},
+	{"", "pih", "Pitcairn-Norfolk"},
+	{"pl", "pol", "Polish"},
+	{"pt", "por", "Portuguese"},
+	{"ps", "pus", "Pushto"},
+	{"qu", "que", "Quechua"},
+	{"", "rar", "Rarotongan"},
+	{"rm", "roh", "Romansh"},
+	{"ro", "ron", "Romanian"},
+	{"rn", "run", "Rundi"},
+	{"ru", "rus", "Russian"},
+	{"sg", "sag", "Sango"},
+	{"si", "sin", "Sinhala"},
+	{"sk", "slk", "Slovak"},
+	{"sl", "slv", "Slovenian"},
+	{"sm", "smo", "Samoan"},
+	{"sn", "sna", "Shona"},
+	{"so", "som", "Somali"},
+	{"st", "sot", "Southern Sotho"},
+	{"es", "spa", "Spanish"},
+	{"sq", "sqi", "Albanian"},
+	{"sr", "srp", "Serbian"},
+	{"ss", "ssw", "Swati"},
+	{"sw", "swa", "Swahili"},
+	{"sv", "swe", "Swedish"},
+	{"ty", "tah", "Tahitian"},
+	{"ta", "tam", "Tamil"},
+	{"", "tet", "Tetum"},
+	{"tg", "tgk", "Tajik"},
+	{"th", "tha", "Thai"},
+	{"ti", "tir", "Tigrinya"},
+	{"", "tkl", "Tokelau"},
+	{"to", "ton", "Tonga (Tonga Islands)"},
+	{"", "tpi", "Tok Pisin"},
+	{"tn", "tsn", "Tswana"},
+	{"tk", "tuk", "Turkmen"},
+	{"tr", "tur", "Turkish"},
+	{"", "tvl", "Tuvalu"},
+	{"uk", "ukr", "Ukrainian"},
+	{"ur", "urd", "Urdu"},
+	{"uz", "uzb", "Uzbek"},
+	{"vi", "vie", "Vietnamese"},
+	{"", "wls", "Wallisian"},
+	{"wo", "wol", "Wolof"},
+	{"xh", "xho", "Xhosa"},
+	{"", "zdj", "Ngazidja Comorian"},
+	{"", "zgh", "Standard Moroccan Tamazight"},
+	{"zh", "zho", "Chinese"},
+	{"zu", "zul", "Zulu"},
+}
+
+// countryLanguages holds the ISO 639-3 codes of the official and main national languages of each
+// country, the most widely spoken first, keyed by alpha-2 country code.
+var countryLanguages = map[string][]string{
+	"AD": {"cat"}, "AE": {"ara"}, "AF": {"fas", "pus"}, "AG": {"eng"}, "AI": {"eng"},
+	"AL": {"sqi"}, "AM": {"hye"}, "AO": {"por"}, "AR": {"spa"}, "AS": {"smo", "eng"},
+	"AT": {"deu"}, "AU": {"eng"}, "AW": {"pap", "nld"}, "AZ": {"aze"}, "BA": {"bos", "hrv", "srp"},
+	"BB": {"eng"}, "BD": {"ben"}, "BE": {"nld", "fra", "deu"}, "BF": {"fra"}, "BG": {"bul"},
+	"BH": {"ara"}, "BI": {"run", "fra", "eng"}, "BJ": {"fra"}, "BM": {"eng"}, "BN": {"msa"},
+	"BO": {"spa", "que", "aym", "grn"}, "BR": {"por"}, "BS": {"eng"}, "BT": {"dzo"}, "BW": {"eng", "tsn"},
+	"BY": {"rus", "bel"}, "BZ": {"eng"}, "CA": {"eng", "fra"}, "CC": {"eng", "msa"}, "CD": {"fra"},
+	"CF": {"sag", "fra"}, "CH": {"deu", "fra", "ita", "roh"}, "CI": {"fra"}, "CK": {"eng", "rar"}, "CL": {"spa"},
+	"CM": {"fra", "eng"}, "CN": {"zho"}, "CO": {"spa"}, "CR": {"spa"}, "CU": {"spa"},
+	"CV": {"por"}, "CX": {"eng"}, "CY": {"ell", "tur"}, "CZ": {"ces"}, "DE": {"deu"},
+	"DJ": {"fra", "ara"}, "DK": {"dan"}, "DM": {"eng"}, "DO": {"spa"}, "DZ": {"ara"},
+	"EC": {"spa"}, "EE": {"est"}, "EG": {"ara"}, "EH": {"ara"}, "ER": {"tir", "ara", "eng"},
+	"ES": {"spa", "cat", "glg", "eus"}, "ET": {"amh", "orm", "som", "tir", "aar"}, "FI": {"fin", "swe"}, "FJ": {"eng", "fij", "hif"}, "FK": {"eng"},
+	"FM": {"eng"}, "FO": {"fao", "dan"}, "FR": {"fra"}, "GA": {"fra"}, "GB": {"eng"},
+	"GD": {"eng"}, "GE": {"kat"}, "GF": {"fra"}, "GH": {"eng"}, "GI": {"eng"},
+	"GL": {"kal", "dan"}, "GM": {"eng"}, "GN": {"fra"}, "GP": {"fra"}, "GQ": {"spa", "fra", "por"},
+	"GR": {"ell"}, "GS": {"eng"}, "GT": {"spa"}, "GU": {"eng", "cha"}, "GW": {"por"},
+	"GY": {"eng"}, "HK": {"zho", "eng"}, "HN": {"spa"}, "HR": {"hrv"}, "HT": {"hat", "fra"},
+	"HU": {"hun"}, "ID": {"ind"}, "IE": {"eng", "gle"}, "IL": {"heb", "ara"}, "IN": {"hin", "eng"},
+	"IO": {"eng"}, "IQ": {"ara", "kur"}, "IR": {"fas"}, "IS": {"isl"}, "IT": {"ita"},
+	"JM": {"eng"}, "JO": {"ara"}, "JP": {"jpn"}, "KE": {"swa", "eng"}, "KG": {"kir", "rus"},
+	"KH": {"khm"}, "KI": {"eng", "gil"}, "KM": {"zdj", "ara", "fra"}, "KN": {"eng"}, "KP": {"kor"},
+	"KR": {"kor"}, "KW": {"ara"}, "KY": {"eng"}, "KZ": {"kaz", "rus"}, "LA": {"lao"},
+	"LB": {"ara"}, "LC": {"eng"}, "LI": {"deu"}, "LK": {"sin", "tam"}, "LR": {"eng"},
+	"LS": {"sot", "eng"}, "LT": {"lit"}, "LU": {"ltz", "fra", "deu"}, "LV": {"lav"}, "LY": {"ara"},
+	"MA": {"ara", "zgh"}, "MC": {"fra"}, "MD": {"ron"}, "MG": {"mlg", "fra"}, "MH": {"mah", "eng"},
+	"MK": {"mkd", "sqi"}, "ML": {"bam", "fra"}, "MM": {"mya"}, "MN": {"mon"}, "MO": {"zho", "por"},
+	"MP": {"eng", "cha", "cal"}, "MQ": {"fra"}, "MR": {"ara"}, "MS": {"eng"}, "MT": {"mlt", "eng"},
+	"MU": {"fra", "eng"}, "MV": {"div"}, "MW": {"eng", "nya"}, "MX": {"spa"}, "MY": {"msa"},
+	"MZ": {"por"}, "NA": {"eng"}, "NC": {"fra"}, "NE": {"hau", "fra"}, "NF": {"eng", "pih"},
+	"NG": {"eng"}, "NI": {"spa"}, "NL": {"nld"}, "NO": {"nor"}, "NP": {"nep"},
+	"NR": {"nau", "eng"}, "NU": {"niu", "eng"}, "NZ": {"eng", "mri"}, "OM": {"ara"}, "PA": {"spa"},
+	"PE": {"spa", "que", "aym"}, "PF": {"fra", "tah"}, "PG": {"tpi", "eng", "hmo"}, "PH": {"fil", "eng"}, "PK": {"urd", "eng"},
+	"PL": {"pol"}, "PM": {"fra"}, "PN": {"eng", "pih"}, "PR": {"spa", "eng"}, "PT": {"por"},
+	"PW": {"pau", "eng"}, "PY": {"spa", "grn"}, "QA": {"ara"}, "RE": {"fra"}, "RO": {"ron"},
+	"RU": {"rus"}, "RW": {"kin", "fra", "eng", "swa"}, "SA": {"ara"}, "SB": {"eng"}, "SC": {"crs", "eng", "fra"},
+	"SD": {"ara", "eng"}, "SE": {"swe"}, "SG": {"eng", "msa", "zho", "tam"}, "SH": {"eng"}, "SI": {"slv"},
+	"SJ": {"nor"}, "SK": {"slk"}, "SL": {"eng"}, "SM": {"ita"}, "SN": {"wol", "fra"},
+	"SO": {"som", "ara"}, "SR": {"nld"}, "ST": {"por"}, "SV": {"spa"}, "SY": {"ara"},
+	"SZ": {"ssw", "eng"}, "TC": {"eng"}, "TD": {"ara", "fra"}, "TF": {"fra"}, "TG": {"fra"},
+	"TH": {"tha"}, "TJ": {"tgk", "rus"}, "TK": {"tkl", "eng"}, "TM": {"tuk"}, "TN": {"ara"},
+	"TO": {"ton", "eng"}, "TP": {"tet", "por"}, "TR": {"tur"}, "TT": {"eng"}, "TV": {"tvl", "eng"},
+	"TW": {"zho"}, "TZ": {"swa", "eng"}, "UA": {"ukr"}, "UG": {"eng", "swa"}, "UM": {"eng"},
+	"US": {"eng"}, "UY": {"spa"}, "UZ": {"uzb"}, "VA": {"ita", "lat"}, "VC": {"eng"},
+	"VE": {"spa"}, "VG": {"eng"}, "VI": {"eng"}, "VN": {"vie"}, "VU": {"bis", "eng", "fra"},
+	"WF": {"fra", "wls"}, "WS": {"smo", "eng"}, "YE": {"ara"}, "YT": {"fra"}, "ZA": {"eng", "zul", "xho", "afr"},
+	"ZM": {"eng"}, "ZW": {"eng", "sna", "nde"},
+}
+
+var languageMap map[string]Language
+var languageCountries map[string][]string
+
+func init() {
+	languageMap = make(map[string]Language)
+	languageCountries = make(map[string][]string)
+
+	for _, language := range languages {
+		languageMap[language.Code3] = language
+		if language.Code != "" {
+			languageMap[language.Code] = language
+		}
+	}
+	for countryCode, codes := range countryLanguages {
+		for _, code := range codes {
+			languageCountries[code] = append(languageCountries[code], countryCode)
+		}
+	}
+	for _, countryCodes := range languageCountries {
+		sort.Strings(countryCodes)
+	}
+}
+
+// AllLanguages returns the languages used in the countries of the embedded table, sorted by ISO 639-3 code.
+func AllLanguages() []Language {
+	return append([]Language(nil), languages...)
+}
+
+// LanguageGet returns the language with the given ISO 639-1 ("fr") or ISO 639-3 ("fra") code.
+func LanguageGet(language string) (Language, error) {
+	l, ok := languageMap[language]
+	if !ok {
+		return Language{}, &LanguageNotFoundError{Language: language}
+	}
+	return l, nil
+}
+
+// CountryGetLanguages returns the official and main national languages of a country, the most
+// widely spoken first.
+func (r *Registry) CountryGetLanguages(countryCode string) ([]Language, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return nil, err
+	}
+	var result []Language
+	for _, code := range countryLanguages[countryCode] {
+		result = append(result, languageMap[code])
+	}
+	return result, nil
+}
+
+// LanguageGetCountries returns the countries using the language with the given ISO 639-1 or
+// ISO 639-3 code, sorted by country code.
+func (r *Registry) LanguageGetCountries(language string) ([]string, error) {
+	l, err := LanguageGet(language)
+	if err != nil {
+		return nil, err
+	}
+	var countries []string
+	for _, countryCode := range languageCountries[l.Code3] {
+		if _, ok := r.countryMap[countryCode]; ok {
+			countries = append(countries, countryCode)
+		}
+	}
+	return countries, nil
+}
+
+// CountryGetLocale returns a best-guess BCP 47 language tag for a country, made of its most widely
+// spoken language and its country code, such as "fr-FR" or "en-CA". The ISO 639-3 code is used for
+// languages without an ISO 639-1 code ("fil-PH"). It returns an empty string for countries without
+// a known language.
+func (r *Registry) CountryGetLocale(countryCode string) (string, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return "", err
+	}
+	codes := countryLanguages[countryCode]
+	if len(codes) == 0 {
+		return "", nil
+	}
+	language := languageMap[codes[0]]
+	subtag := language.Code
+	if subtag == "" {
+		subtag = language.Code3
+	}
+	if current, ok := currentCodes[countryCode]; ok {
+		countryCode = current
+	}
+	return subtag + "-" + countryCode, nil
+}
+
+// CountryGetLanguages returns the official and main national languages of a country, the most
+// widely spoken first.
+func CountryGetLanguages(countryCode string) ([]Language, error) {
+	return defaultRegistry.CountryGetLanguages(countryCode)
+}
+
+// LanguageGetCountries returns the countries using the language with the given ISO 639-1 or
+// ISO 639-3 code, sorted by country code.
+func LanguageGetCountries(language string) ([]string, error) {
+	return defaultRegistry.LanguageGetCountries(language)
+}
+
+// CountryGetLocale returns a best-guess BCP 47 language tag for a country, made of its most widely
+// spoken language and its country code, such as "fr-FR" or "en-CA".
+func CountryGetLocale(countryCode string) (string, error) {
+	return defaultRegistry.CountryGetLocale(countryCode)
+}
@@ -0,0 +1,118 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryGetLanguages(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          []Language
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: []Language{{"fr", "fra", "French"}}},
+		{name: "Canada", code: "CA", want: []Language{{"en", "eng", "English"}, {"fr", "fra", "French"}}},
+		{name: "No ISO 639-1 code", code: "PH", want: []Language{{"", "fil", "Filipino"}, {"en", "eng", "English"}}},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetLanguages(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetLanguages(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CountryGetLanguages(%s) = %v; want %v", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLanguageGetCountries(t *testing.T) {
+	tests := []struct {
+		name          string
+		language      string
+		want          []string
+		expectedError error
+	}{
+		{name: "ISO 639-1 code", language: "de", want: []string{"AT", "BE", "CH", "DE", "LI", "LU"}},
+		{name: "ISO 639-3 code", language: "deu", want: []string{"AT", "BE", "CH", "DE", "LI", "LU"}},
+		{name: "Language without ISO 639-1 code", language: "pih", want: []string{"NF", "PN"}},
+		{name: "Unknown language", language: "xx", expectedError: &LanguageNotFoundError{Language: "xx"}},
+		{name: "Upper case", language: "DE", expectedError: &LanguageNotFoundError{Language: "DE"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := LanguageGetCountries(tc.language)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("LanguageGetCountries(%s) error = %v, wantError %v", tc.language, err, tc.expectedError)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("LanguageGetCountries(%s) = %v; want %v", tc.language, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryGetLocale(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: "fr-FR"},
+		{name: "Canada", code: "CA", want: "en-CA"},
+		{name: "Switzerland", code: "CH", want: "de-CH"},
+		{name: "No ISO 639-1 code", code: "PH", want: "fil-PH"},
+		{name: "Former country code", code: "TP", want: "tet-TL"},
+		{name: "Creole before official French", code: "HT", want: "ht-HT"},
+		{name: "Wolof before official French", code: "SN", want: "wo-SN"},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetLocale(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetLocale(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("CountryGetLocale(%s) = %s; want %s", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLanguageTablesConsistent(t *testing.T) {
+	for _, c := range countryContinent {
+		if len(countryLanguages[c.CountryCode]) == 0 {
+			t.Errorf("%s: no language", c.CountryCode)
+		}
+	}
+	for countryCode, codes := range countryLanguages {
+		if _, ok := defaultRegistry.countryMap[countryCode]; !ok {
+			t.Errorf("languages for unknown country %s", countryCode)
+		}
+		seen := make(map[string]bool)
+		for _, code := range codes {
+			if _, ok := languageMap[code]; !ok || len(code) != 3 {
+				t.Errorf("%s: unknown ISO 639-3 code %s", countryCode, code)
+			}
+			if seen[code] {
+				t.Errorf("%s: duplicate language %s", countryCode, code)
+			}
+			seen[code] = true
+		}
+	}
+	for i, language := range languages {
+		if i > 0 && languages[i-1].Code3 >= language.Code3 {
+			t.Errorf("languages not sorted at %s", language.Code3)
+		}
+		if len(languageCountries[language.Code3]) == 0 {
+			t.Errorf("language %s is not used by any country", language.Code3)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Region is an area of the UN M49 geoscheme: the world, a region, a sub-region or an intermediate region.
+type Region struct {
+	Code   string // M49 numeric area code
+	Name   string // Name of the area
+	Parent string // M49 code of the enclosing area, empty for the world
+}
+
+// ErrRegionNotFound matches RegionNotFoundError with errors.Is.
+var ErrRegionNotFound = errors.New("region not found")
+
+// RegionNotFoundError is returned when a UN M49 area code or name is not found.
+// It matches ErrRegionNotFound.
+type RegionNotFoundError struct {
+	Region string
+}
+
+func (e *RegionNotFoundError) Error() string {
+	return fmt.Sprintf("region not found: %s", e.Region)
+}
+
+// Is reports whether target is ErrRegionNotFound.
+func (e *RegionNotFoundError) Is(target error) bool {
+	return target == ErrRegionNotFound
+}
+
+// m49Regions is the UN M49 area hierarchy, from the world down to intermediate regions.
+var m49Regions = []Region{
+	{"001", "World", ""},
+	{"002", "Africa", "001"},
+	{"015", "Northern Africa", "002"},
+	{"202", "Sub-Saharan Africa", "002"},
+	{"014", "Eastern Africa", "202"},
+	{"017", "Middle Africa", "202"},
+	{"018", "Southern Africa", "202"},
+	{"011", "Western Africa", "202"},
+	{"010", "Antarctica", "001"},
+	{"019", "Americas", "001"},
+	{"419", "Latin America and the Caribbean", "019"},
+	{"029", "Caribbean", "419"},
+	{"013", "Central America", "419"},
+	{"005", "South America", "419"},
+	{"021", "Northern America", "019"},
+	{"142", "Asia", "001"},
+	{"143", "Central Asia", "142"},
+	{"030", "Eastern Asia", "142"},
+	{"035", "South-eastern Asia", "142"},
+	{"034", "Southern Asia", "142"},
+	{"145", "Western Asia", "142"},
+	{"150", "Europe", "001"},
+	{"151", "Eastern Europe", "150"},
+	{"154", "Northern Europe", "150"},
+	{"830", "Channel Islands", "154"},
+	{"039", "Southern Europe", "150"},
+	{"155", "Western Europe", "150"},
+	{"009", "Oceania", "001"},
+	{"053", "Australia and New Zealand", "009"},
+	{"054", "Melanesia", "009"},
+	{"057", "Micronesia", "009"},
+	{"061", "Polynesia", "009"},
+}
+
+// m49RegionCountries holds the alpha-2 country codes of the innermost M49 area of each country.
+// Taiwan is not listed by the UN and is placed in Eastern Asia.
+var m49RegionCountries = map[string][]string{
+	"015": {"DZ", "EG", "EH", "LY", "MA", "SD", "TN"},
+	"014": {"BI", "DJ", "ER", "ET", "IO", "KE", "KM", "MG", "MU", "MW", "MZ", "RE", "RW", "SC", "SO", "SS", "TF", "TZ", "UG", "YT", "ZM", "ZW"},
+	"017": {"AO", "CD", "CF", "CG", "CM", "GA", "GQ", "ST", "TD"},
+	"018": {"BW", "LS", "NA", "SZ", "ZA"},
+	"011": {"BF", "BJ", "CI", "CV", "GH", "GM", "GN", "GW", "LR", "ML", "MR", "NE", "NG", "SH", "SL", "SN", "TG"},
+	"029": {"AG", "AI", "AW", "BB", "BL", "BQ", "BS", "CU", "CW", "DM", "DO", "GD", "GP", "HT", "JM", "KN", "KY", "LC", "MF", "MQ", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"},
+	"013": {"BZ", "CR", "GT", "HN", "MX", "NI", "PA", "SV"},
+	"005": {"AR", "BO", "BR", "BV", "CL", "CO", "EC", "FK", "GF", "GS", "GY", "PE", "PY", "SR", "UY", "VE"},
+	"021": {"BM", "CA", "GL", "PM", "US"},
+	"010": {"AQ"},
+	"143": {"KG", "KZ", "TJ", "TM", "UZ"},
+	"030": {"CN", "HK", "JP", "KP", "KR", "MN", "MO", "TW"},
+	"035": {"BN", "ID", "KH", "LA", "MM", "MY", "PH", "SG", "TH", "TL", "TP", "VN"},
+	"034": {"AF", "BD", "BT", "IN", "IR", "LK", "MV", "NP", "PK"},
+	"145": {"AE", "AM", "AZ", "BH", "CY", "GE", "IL", "IQ", "JO", "KW", "LB", "OM", "PS", "QA", "SA", "SY", "TR", "YE"},
+	"151": {"BG", "BY", "CZ", "HU", "MD", "PL", "RO", "RU", "SK", "UA"},
+	"154": {"AX", "DK", "EE", "FI", "FO", "GB", "IE", "IM", "IS", "LT", "LV", "NO", "SE", "SJ"},
+	"830": {"GG", "JE"},
+	"039": {"AD", "AL", "BA", "ES", "GI", "GR", "HR", "IT", "ME", "MK", "MT", "PT", "RS", "SI", "SM", "VA"},
+	"155": {"AT", "BE", "CH", "DE", "FR", "LI", "LU", "MC", "NL"},
+	"053": {"AU", "CC", "CX", "HM", "NF", "NZ"},
+	"054": {"FJ", "NC", "PG", "SB", "VU"},
+	"057": {"FM", "GU", "KI", "MH", "MP", "NR", "PW", "UM"},
+	"061": {"AS", "CK", "NU", "PF", "PN", "TK", "TO", "TV", "WF", "WS"},
+}
+
+var m49RegionMap map[string]Region
+var m49Children map[string][]string
+var m49CountryRegion map[string]string
+
+func init() {
+	m49RegionMap = make(map[string]Region)
+	m49Children = make(map[string][]string)
+	m49CountryRegion = make(map[string]string)
+
+	for _, region := range m49Regions {
+		m49RegionMap[region.Code] = region
+		if region.Parent != "" {
+			m49Children[region.Parent] = append(m49Children[region.Parent], region.Code)
+		}
+	}
+	for regionCode, countryCodes := range m49RegionCountries {
+		for _, countryCode := range countryCodes {
+			m49CountryRegion[countryCode] = regionCode
+		}
+	}
+}
+
+// findRegion returns the M49 area with the given numeric code or case-insensitive name.
+func findRegion(region string) (Region, error) {
+	if r, ok := m49RegionMap[region]; ok {
+		return r, nil
+	}
+	name := strings.TrimSpace(region)
+	for _, r := range m49Regions {
+		if strings.EqualFold(r.Name, name) {
+			return r, nil
+		}
+	}
+	return Region{}, &RegionNotFoundError{Region: region}
+}
+
+// AllRegions returns all areas of the M49 hierarchy, each one listed before the areas it encloses.
+func AllRegions() []Region {
+	return append([]Region(nil), m49Regions...)
+}
+
+// RegionGet returns the M49 area with the given numeric code (such as "155") or name (such as
+// "Western Europe"), ignoring case.
+func RegionGet(region string) (Region, error) {
+	return findRegion(region)
+}
+
+// RegionGetChildren returns the areas directly enclosed by the given M49 area.
+func RegionGetChildren(region string) ([]Region, error) {
+	r, err := findRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]Region, 0, len(m49Children[r.Code]))
+	for _, code := range m49Children[r.Code] {
+		children = append(children, m49RegionMap[code])
+	}
+	return children, nil
+}
+
+// RegionGetCountries returns the sorted country codes of all countries within the given M49 area,
+// including those of enclosed areas. Only countries present in the registry are returned.
+func (r *Registry) RegionGetCountries(region string) ([]string, error) {
+	area, err := findRegion(region)
+	if err != nil {
+		return nil, err
+	}
+	var countries []string
+	pending := []string{area.Code}
+	for len(pending) > 0 {
+		code := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, countryCode := range m49RegionCountries[code] {
+			if _, ok := r.countryMap[countryCode]; ok {
+				countries = append(countries, countryCode)
+			}
+		}
+		pending = append(pending, m49Children[code]...)
+	}
+	sort.Strings(countries)
+	return countries, nil
+}
+
+// CountryGetRegions returns the M49 areas that enclose a country, from the innermost area up to the world.
+func (r *Registry) CountryGetRegions(countryCode string) ([]Region, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return nil, err
+	}
+	return countryRegions(countryCode), nil
+}
+
+// RegionGetCountries returns the sorted country codes of all countries within the given M49 area,
+// including those of enclosed areas. Only countries present in the country table are returned.
+func RegionGetCountries(region string) ([]string, error) {
+	return defaultRegistry.RegionGetCountries(region)
+}
+
+// CountryGetRegions returns the M49 areas that enclose a country, from the innermost area up to the world.
+func CountryGetRegions(countryCode string) ([]Region, error) {
+	return defaultRegistry.CountryGetRegions(countryCode)
+}
+
+// countryRegions returns the M49 areas that enclose a country, from the innermost area up to the world,
+// or nil if the country is not part of the M49 hierarchy.
+func countryRegions(countryCode string) []Region {
+	var regions []Region
+	for code := m49CountryRegion[countryCode]; code != ""; code = m49RegionMap[code].Parent {
+		regions = append(regions, m49RegionMap[code])
+	}
+	return regions
+}
+
+// countryRegionAtDepth returns the name of the M49 area enclosing a country at the given depth below
+// the world (1 for regions, 2 for sub-regions), or an empty string if there is none.
+func countryRegionAtDepth(countryCode string, depth int) string {
+	regions := countryRegions(countryCode)
+	i := len(regions) - 1 - depth
+	if i < 0 {
+		return ""
+	}
+	return regions[i].Name
+}
@@ -0,0 +1,138 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegionGet(t *testing.T) {
+	tests := []struct {
+		name          string
+		region        string
+		want          Region
+		expectedError error
+	}{
+		{name: "By code", region: "155", want: Region{Code: "155", Name: "Western Europe", Parent: "150"}},
+		{name: "By name", region: "Sub-Saharan Africa", want: Region{Code: "202", Name: "Sub-Saharan Africa", Parent: "002"}},
+		{name: "By lowercase name", region: "south-eastern asia", want: Region{Code: "035", Name: "South-eastern Asia", Parent: "142"}},
+		{name: "World", region: "001", want: Region{Code: "001", Name: "World", Parent: ""}},
+		{name: "Unknown code", region: "999", expectedError: &RegionNotFoundError{Region: "999"}},
+		{name: "Unknown name", region: "Atlantis", expectedError: &RegionNotFoundError{Region: "Atlantis"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := RegionGet(tc.region)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("RegionGet(%s) error = %v, wantError %v", tc.region, err, tc.expectedError)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("RegionGet(%s) = %v; want %v", tc.region, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryGetRegions(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          []string
+		expectedError error
+	}{
+		{name: "Sub-region only", code: "FR", want: []string{"155", "150", "001"}},
+		{name: "Intermediate region", code: "KE", want: []string{"014", "202", "002", "001"}},
+		{name: "Caribbean", code: "JM", want: []string{"029", "419", "019", "001"}},
+		{name: "Northern America", code: "US", want: []string{"021", "019", "001"}},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetRegions(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetRegions(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			var codes []string
+			for _, r := range got {
+				codes = append(codes, r.Code)
+			}
+			if err == nil && !reflect.DeepEqual(codes, tc.want) {
+				t.Errorf("CountryGetRegions(%s) = %v; want %v", tc.code, codes, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegionGetCountries(t *testing.T) {
+	tests := []struct {
+		name          string
+		region        string
+		want          []string
+		expectedError error
+	}{
+		{name: "Leaf region", region: "Western Europe", want: []string{"AT", "BE", "CH", "DE", "FR", "LI", "LU", "MC", "NL"}},
+		{name: "Southern Africa by code", region: "018", want: []string{"BW", "LS", "NA", "SZ", "ZA"}},
+		{name: "Central Asia", region: "central asia", want: []string{"KG", "KZ", "TJ", "TM", "UZ"}},
+		{name: "Unknown region", region: "Atlantis", expectedError: &RegionNotFoundError{Region: "Atlantis"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := RegionGetCountries(tc.region)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("RegionGetCountries(%s) error = %v, wantError %v", tc.region, err, tc.expectedError)
+			}
+			if err == nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("RegionGetCountries(%s) = %v; want %v", tc.region, got, tc.want)
+			}
+		})
+	}
+
+	world, err := RegionGetCountries("World")
+	if err != nil {
+		t.Fatalf("RegionGetCountries(World) error = %v", err)
+	}
+	if len(world) != len(countryContinent) {
+		t.Errorf("RegionGetCountries(World) returned %d countries, want %d", len(world), len(countryContinent))
+	}
+	americas, _ := RegionGetCountries("Americas")
+	for _, code := range []string{"US", "JM", "PA", "BR"} {
+		if !StringInSlice(code, americas) {
+			t.Errorf("RegionGetCountries(Americas) is missing %s", code)
+		}
+	}
+}
+
+func TestRegionGetChildren(t *testing.T) {
+	got, err := RegionGetChildren("019")
+	if err != nil {
+		t.Fatalf("RegionGetChildren(019) error = %v", err)
+	}
+	want := []Region{{"419", "Latin America and the Caribbean", "019"}, {"021", "Northern America", "019"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RegionGetChildren(019) = %v; want %v", got, want)
+	}
+	if _, err := RegionGetChildren("XX"); !sameError(err, &RegionNotFoundError{Region: "XX"}) {
+		t.Errorf("RegionGetChildren(XX) error = %v, want RegionNotFoundError", err)
+	}
+}
+
+func TestM49HierarchyConsistent(t *testing.T) {
+	for _, country := range countryContinent {
+		if _, ok := m49CountryRegion[country.CountryCode]; !ok {
+			t.Errorf("%s has no M49 region", country.CountryCode)
+		}
+	}
+	for _, region := range AllRegions() {
+		if region.Parent == "" {
+			continue
+		}
+		if _, ok := m49RegionMap[region.Parent]; !ok {
+			t.Errorf("region %s has unknown parent %s", region.Code, region.Parent)
+		}
+	}
+	for code := range m49RegionCountries {
+		if _, ok := m49RegionMap[code]; !ok {
+			t.Errorf("countries listed under unknown region %s", code)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrNoContinent matches NoContinentError with errors.Is.
+var ErrNoContinent = errors.New("no continent for country")
+
+// NoContinentError is returned when a continent model cannot place a country, such as a country
+// missing from the UN M49 table. It matches ErrNoContinent.
+type NoContinentError struct {
+	Model       string
+	CountryCode string
+}
+
+func (e *NoContinentError) Error() string {
+	return fmt.Sprintf("no continent for country %s in model %s", e.CountryCode, e.Model)
+}
+
+// Is reports whether target is ErrNoContinent.
+func (e *NoContinentError) Is(target error) bool {
+	return target == ErrNoContinent
+}
+
+// ContinentModel assigns countries to continents. Models differ in how many continents they
+// use and where they draw the boundaries between them.
+type ContinentModel interface {
+	// Name returns a short description of the model.
+	Name() string
+	// ContinentOf returns the continent the model assigns to a country, or an empty string if the
+	// model cannot place it.
+	ContinentOf(country CountryContinent) string
+}
+
+// continentModel is a ContinentModel backed by a mapping function.
+type continentModel struct {
+	name        string
+	continentOf func(country CountryContinent) string
+}
+
+func (m continentModel) Name() string {
+	return m.name
+}
+
+func (m continentModel) ContinentOf(country CountryContinent) string {
+	return m.continentOf(country)
+}
+
+var (
+	// TableContinentModel uses the continents of the country table as-is, with the Caribbean
+	// and Central America kept apart from North America.
+	TableContinentModel ContinentModel = continentModel{
+		name: "table",
+		continentOf: func(country CountryContinent) string {
+			return country.Continent
+		},
+	}
+
+	// SevenContinentModel uses the seven continents Africa, Antarctica, Asia, Europe,
+	// North America, Oceania and South America, with the Caribbean and Central America
+	// folded into North America.
+	SevenContinentModel ContinentModel = continentModel{
+		name: "seven-continent",
+		continentOf: func(country CountryContinent) string {
+			switch country.Continent {
+			case "Caribbean", "Central America":
+				return "North America"
+			}
+			return country.Continent
+		},
+	}
+
+	// AmericasContinentModel uses six continents, with North America, Central America, the
+	// Caribbean and South America combined into the Americas.
+	AmericasContinentModel ContinentModel = continentModel{
+		name: "americas",
+		continentOf: func(country CountryContinent) string {
+			switch country.Continent {
+			case "Caribbean", "Central America", "North America", "South America":
+				return "Americas"
+			}
+			return country.Continent
+		},
+	}
+
+	// UNRegionModel uses the regions of the UN M49 standard: Africa, Americas, Asia, Europe and Oceania.
+	UNRegionModel ContinentModel = continentModel{
+		name: "un-region",
+		continentOf: func(country CountryContinent) string {
+			return countryRegionAtDepth(country.CountryCode, 1)
+		},
+	}
+
+	// UNSubRegionModel uses the sub-regions of the UN M49 standard, such as "Western Europe"
+	// or "Sub-Saharan Africa".
+	UNSubRegionModel ContinentModel = continentModel{
+		name: "un-sub-region",
+		continentOf: func(country CountryContinent) string {
+			return countryRegionAtDepth(country.CountryCode, 2)
+		},
+	}
+
+	// UNIntermediateRegionModel uses the innermost area of the UN M49 standard: the intermediate
+	// region where there is one, such as "Caribbean" or "Eastern Africa", and the sub-region otherwise.
+	UNIntermediateRegionModel ContinentModel = continentModel{
+		name: "un-intermediate-region",
+		continentOf: func(country CountryContinent) string {
+			if regions := countryRegions(country.CountryCode); len(regions) > 0 {
+				return regions[0].Name
+			}
+			return ""
+		},
+	}
+)
+
+// CountryGetContinentModel returns the continent of a country from its country code, using the given model.
+// It returns a *NoContinentError if the model cannot place the country.
+func (r *Registry) CountryGetContinentModel(model ContinentModel, countryCode string) (string, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return "", err
+	}
+	continent := model.ContinentOf(country)
+	if continent == "" {
+		return "", &NoContinentError{Model: model.Name(), CountryCode: countryCode}
+	}
+	return continent, nil
+}
+
+// ContinentGetCountriesModel returns a list of countries in a continent of the given model, sorted by country code.
+func (r *Registry) ContinentGetCountriesModel(model ContinentModel, continent string) ([]string, error) {
+	if continent == "" {
+		return nil, continentNotFound(continent, r.ModelContinents(model))
+	}
+	var countries []string
+	for _, code := range r.sortedCodes {
+		if model.ContinentOf(r.countryMap[code]) == continent {
+			countries = append(countries, code)
+		}
+	}
+	if len(countries) == 0 {
+		return nil, continentNotFound(continent, r.ModelContinents(model))
+	}
+	return countries, nil
+}
+
+// ModelContinents returns the continents of the given model, sorted by name.
+func (r *Registry) ModelContinents(model ContinentModel) []string {
+	seen := make(map[string]bool)
+	var continents []string
+	for _, country := range r.countries {
+		continent := model.ContinentOf(country)
+		if continent != "" && !seen[continent] {
+			seen[continent] = true
+			continents = append(continents, continent)
+		}
+	}
+	sort.Strings(continents)
+	return continents
+}
+
+// CountryGetContinentModel returns the continent of a country from its country code, using the given model.
+func CountryGetContinentModel(model ContinentModel, countryCode string) (string, error) {
+	return defaultRegistry.CountryGetContinentModel(model, countryCode)
+}
+
+// ContinentGetCountriesModel returns a list of countries in a continent of the given model, sorted by country code.
+func ContinentGetCountriesModel(model ContinentModel, continent string) ([]string, error) {
+	return defaultRegistry.ContinentGetCountriesModel(model, continent)
+}
+
+// ModelContinents returns the continents of the given model, sorted by name.
+func ModelContinents(model ContinentModel) []string {
+	return defaultRegistry.ModelContinents(model)
+}
@@ -0,0 +1,106 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryGetContinentModel(t *testing.T) {
+	tests := []struct {
+		name          string
+		model         ContinentModel
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "Table model keeps Caribbean", model: TableContinentModel, code: "DM", want: "Caribbean"},
+		{name: "Seven continents folds Caribbean", model: SevenContinentModel, code: "DM", want: "North America"},
+		{name: "Seven continents folds Central America", model: SevenContinentModel, code: "PA", want: "North America"},
+		{name: "Seven continents keeps South America", model: SevenContinentModel, code: "PE", want: "South America"},
+		{name: "Americas combines North America", model: AmericasContinentModel, code: "US", want: "Americas"},
+		{name: "Americas combines South America", model: AmericasContinentModel, code: "PE", want: "Americas"},
+		{name: "Americas keeps Europe", model: AmericasContinentModel, code: "FR", want: "Europe"},
+		{name: "UN region", model: UNRegionModel, code: "FR", want: "Europe"},
+		{name: "UN region Americas", model: UNRegionModel, code: "MX", want: "Americas"},
+		{name: "UN region moves Cyprus to Asia", model: UNRegionModel, code: "CY", want: "Asia"},
+		{name: "UN sub-region", model: UNSubRegionModel, code: "FR", want: "Western Europe"},
+		{name: "UN sub-region Asia", model: UNSubRegionModel, code: "VN", want: "South-eastern Asia"},
+		{name: "UN sub-region above intermediate region", model: UNSubRegionModel, code: "DM", want: "Latin America and the Caribbean"},
+		{name: "UN intermediate region", model: UNIntermediateRegionModel, code: "DM", want: "Caribbean"},
+		{name: "UN intermediate region falls back to sub-region", model: UNIntermediateRegionModel, code: "FR", want: "Western Europe"},
+		{name: "Unknown code", model: SevenContinentModel, code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", model: SevenContinentModel, code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetContinentModel(tc.model, tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetContinentModel(%s, %s) error = %v, wantError %v", tc.model.Name(), tc.code, err, tc.expectedError)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("CountryGetContinentModel(%s, %s) = %s; want %s", tc.model.Name(), tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContinentGetCountriesModel(t *testing.T) {
+	northAmerica, err := ContinentGetCountriesModel(SevenContinentModel, "North America")
+	if err != nil {
+		t.Fatalf("ContinentGetCountriesModel(seven-continent, North America) error = %v", err)
+	}
+	for _, code := range []string{"US", "CA", "MX", "PA", "CU", "JM"} {
+		if !StringInSlice(code, northAmerica) {
+			t.Errorf("seven-continent North America is missing %s", code)
+		}
+	}
+	if _, err := ContinentGetCountriesModel(SevenContinentModel, "Caribbean"); !sameError(err, &ContinentNotFoundError{Continent: "Caribbean"}) {
+		t.Errorf("ContinentGetCountriesModel(seven-continent, Caribbean) error = %v, want ContinentNotFoundError", err)
+	}
+	table, err := ContinentGetCountriesModel(TableContinentModel, "Europe")
+	if err != nil {
+		t.Fatalf("ContinentGetCountriesModel(table, Europe) error = %v", err)
+	}
+	want, _ := getCountriesByContinent("Europe")
+	if !equalSlices(table, want) {
+		t.Errorf("ContinentGetCountriesModel(table, Europe) = %v; want %v", table, want)
+	}
+}
+
+func TestContinentModelUnplacedCountry(t *testing.T) {
+	r, err := NewOverlay(AddCountry(CountryContinent{CountryCode: "XK", CountryName: "Kosovo", Continent: "Europe"}))
+	if err != nil {
+		t.Fatalf("NewOverlay() error = %v", err)
+	}
+	if got, err := r.CountryGetContinentModel(UNRegionModel, "XK"); !sameError(err, &NoContinentError{Model: "un-region", CountryCode: "XK"}) {
+		t.Errorf("CountryGetContinentModel(un-region, XK) = %q, %v; want NoContinentError", got, err)
+	}
+	if got, err := r.CountryGetContinentModel(SevenContinentModel, "XK"); got != "Europe" || err != nil {
+		t.Errorf("CountryGetContinentModel(seven-continent, XK) = %q, %v; want Europe, nil", got, err)
+	}
+	if got, err := r.ContinentGetCountriesModel(UNRegionModel, ""); !sameError(err, &ContinentNotFoundError{Continent: ""}) {
+		t.Errorf("ContinentGetCountriesModel(un-region, \"\") = %v, %v; want ContinentNotFoundError", got, err)
+	}
+	europe, _ := r.ContinentGetCountriesModel(UNRegionModel, "Europe")
+	if StringInSlice("XK", europe) {
+		t.Errorf("ContinentGetCountriesModel(un-region, Europe) = %v; want it without XK", europe)
+	}
+}
+
+func TestModelContinents(t *testing.T) {
+	tests := []struct {
+		model ContinentModel
+		want  []string
+	}{
+		{model: SevenContinentModel, want: []string{"Africa", "Antarctica", "Asia", "Europe", "North America", "Oceania", "South America"}},
+		{model: AmericasContinentModel, want: []string{"Africa", "Americas", "Antarctica", "Asia", "Europe", "Oceania"}},
+		{model: UNRegionModel, want: []string{"Africa", "Americas", "Asia", "Europe", "Oceania"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.model.Name(), func(t *testing.T) {
+			if got := ModelContinents(tc.model); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ModelContinents(%s) = %v; want %v", tc.model.Name(), got, tc.want)
+			}
+		})
+	}
+}
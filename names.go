@@ -0,0 +1,394 @@
+package countrycontinent
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// fuzzyMinScore is the minimum score a candidate needs to be returned by CountryCodeFromNameFuzzy.
+const fuzzyMinScore = 0.5
+
+// CountryNameNotFoundError is returned when a country name does not match any country.
+// It matches ErrCountryNotFound.
+type CountryNameNotFoundError struct {
+	Name string
+}
+
+func (e *CountryNameNotFoundError) Error() string {
+	return fmt.Sprintf("country name not found: %s", e.Name)
+}
+
+// Is reports whether target is ErrCountryNotFound.
+func (e *CountryNameNotFoundError) Is(target error) bool {
+	return target == ErrCountryNotFound
+}
+
+// NameMatch is a candidate country returned by a fuzzy name lookup.
+type NameMatch struct {
+	CountryCode string  // ISO 3166-1 alpha-2 country code
+	CountryName string  // Full name of the country
+	MatchedName string  // Name or alias that matched the query
+	Score       float64 // Similarity between the query and MatchedName, from 0 to 1
+}
+
+// countryAliases holds alternative names for countries, keyed by alpha-2 country code:
+// official names, common short names, former names and spellings used by other standards.
+var countryAliases = map[string][]string{
+	"AD": {"Principality of Andorra"},
+	"AE": {"UAE", "Emirates"},
+	"AF": {"Islamic Republic of Afghanistan"},
+	"AL": {"Republic of Albania"},
+	"AM": {"Republic of Armenia"},
+	"AO": {"Republic of Angola"},
+	"AR": {"Argentine Republic"},
+	"AT": {"Republic of Austria"},
+	"AZ": {"Republic of Azerbaijan"},
+	"BA": {"Republic of Bosnia and Herzegovina", "Bosnia"},
+	"BD": {"People's Republic of Bangladesh"},
+	"BE": {"Kingdom of Belgium"},
+	"BF": {"Upper Volta"},
+	"BG": {"Republic of Bulgaria"},
+	"BH": {"Kingdom of Bahrain"},
+	"BI": {"Republic of Burundi"},
+	"BJ": {"Republic of Benin", "Dahomey"},
+	"BN": {"Brunei"},
+	"BO": {"Bolivia, Plurinational State of", "Plurinational State of Bolivia"},
+	"BR": {"Federative Republic of Brazil"},
+	"BS": {"Commonwealth of the Bahamas", "The Bahamas"},
+	"BT": {"Kingdom of Bhutan"},
+	"BW": {"Republic of Botswana"},
+	"BY": {"Republic of Belarus", "Byelorussia", "Belorussia"},
+	"BZ": {"British Honduras"},
+	"CF": {"CAR"},
+	"CD": {"Congo, The Democratic Republic of the", "Democratic Republic of the Congo", "DR Congo", "DRC", "Congo-Kinshasa", "Zaire"},
+	"CH": {"Swiss Confederation"},
+	"CI": {"Côte d'Ivoire", "Republic of Côte d'Ivoire", "Ivory Coast"},
+	"CL": {"Republic of Chile"},
+	"CM": {"Republic of Cameroon"},
+	"CN": {"People's Republic of China"},
+	"CO": {"Republic of Colombia"},
+	"CR": {"Republic of Costa Rica"},
+	"CU": {"Republic of Cuba"},
+	"CV": {"Cabo Verde", "Republic of Cabo Verde"},
+	"CY": {"Republic of Cyprus"},
+	"CZ": {"Czechia", "Czech"},
+	"DE": {"Federal Republic of Germany", "Deutschland"},
+	"DJ": {"Republic of Djibouti"},
+	"DK": {"Kingdom of Denmark"},
+	"DM": {"Commonwealth of Dominica"},
+	"DZ": {"People's Democratic Republic of Algeria"},
+	"EC": {"Republic of Ecuador"},
+	"EE": {"Republic of Estonia"},
+	"EG": {"Arab Republic of Egypt"},
+	"ER": {"the State of Eritrea"},
+	"ES": {"Kingdom of Spain", "España"},
+	"ET": {"Federal Democratic Republic of Ethiopia", "Abyssinia"},
+	"FI": {"Republic of Finland"},
+	"FJ": {"Republic of Fiji"},
+	"FK": {"Falkland Islands", "Malvinas"},
+	"FM": {"Micronesia, Federated States of", "Federated States of Micronesia"},
+	"FR": {"French Republic"},
+	"GA": {"Gabonese Republic"},
+	"GB": {"United Kingdom of Great Britain and Northern Ireland", "UK", "U.K.", "Great Britain", "Britain"},
+	"GH": {"Republic of Ghana", "Gold Coast"},
+	"GM": {"Republic of the Gambia", "The Gambia"},
+	"GN": {"Republic of Guinea"},
+	"GQ": {"Republic of Equatorial Guinea"},
+	"GR": {"Hellenic Republic"},
+	"GS": {"South Georgia and the South Sandwich Islands", "South Georgia"},
+	"GT": {"Republic of Guatemala"},
+	"GW": {"Republic of Guinea-Bissau"},
+	"GY": {"Republic of Guyana"},
+	"HK": {"Hong Kong Special Administrative Region of China", "Hongkong"},
+	"HN": {"Republic of Honduras"},
+	"HR": {"Croatia", "Republic of Croatia", "Hrvatska"},
+	"HT": {"Republic of Haiti"},
+	"ID": {"Republic of Indonesia"},
+	"IL": {"State of Israel"},
+	"IN": {"Republic of India"},
+	"IQ": {"Republic of Iraq"},
+	"IR": {"Iran, Islamic Republic of", "Islamic Republic of Iran", "Persia"},
+	"IS": {"Republic of Iceland"},
+	"IT": {"Italian Republic"},
+	"JO": {"Hashemite Kingdom of Jordan"},
+	"KE": {"Republic of Kenya"},
+	"KG": {"Kyrgyz Republic", "Kirghizia"},
+	"KH": {"Kingdom of Cambodia", "Kampuchea"},
+	"KI": {"Republic of Kiribati"},
+	"KM": {"Union of the Comoros"},
+	"KP": {"Korea, Democratic People's Republic of", "Democratic People's Republic of Korea", "North Korea", "DPRK"},
+	"KR": {"Korea, Republic of", "South Korea", "Republic of Korea"},
+	"KW": {"State of Kuwait"},
+	"KZ": {"Republic of Kazakhstan"},
+	"LA": {"Lao People's Democratic Republic", "Lao PDR"},
+	"LB": {"Lebanese Republic"},
+	"LI": {"Principality of Liechtenstein"},
+	"LK": {"Democratic Socialist Republic of Sri Lanka", "Ceylon"},
+	"LR": {"Republic of Liberia"},
+	"LS": {"Kingdom of Lesotho"},
+	"LT": {"Republic of Lithuania"},
+	"LU": {"Grand Duchy of Luxembourg"},
+	"LV": {"Republic of Latvia"},
+	"MA": {"Kingdom of Morocco"},
+	"MC": {"Principality of Monaco"},
+	"MD": {"Moldova, Republic of", "Republic of Moldova", "Moldavia"},
+	"MG": {"Republic of Madagascar"},
+	"MH": {"Republic of the Marshall Islands"},
+	"MK": {"North Macedonia", "Republic of North Macedonia", "FYROM", "Former Yugoslav Republic of Macedonia"},
+	"ML": {"Republic of Mali"},
+	"MM": {"Republic of Myanmar", "Burma"},
+	"MO": {"Macao", "Macao Special Administrative Region of China"},
+	"MP": {"Commonwealth of the Northern Mariana Islands"},
+	"MR": {"Islamic Republic of Mauritania"},
+	"MT": {"Republic of Malta"},
+	"MU": {"Republic of Mauritius"},
+	"MV": {"Republic of Maldives"},
+	"MW": {"Republic of Malawi", "Nyasaland"},
+	"MX": {"United Mexican States"},
+	"MZ": {"Republic of Mozambique"},
+	"NA": {"Republic of Namibia", "South West Africa"},
+	"NE": {"Republic of the Niger"},
+	"NG": {"Federal Republic of Nigeria"},
+	"NI": {"Republic of Nicaragua"},
+	"NL": {"Kingdom of the Netherlands", "Holland", "The Netherlands"},
+	"NO": {"Kingdom of Norway"},
+	"NP": {"Federal Democratic Republic of Nepal"},
+	"NR": {"Republic of Nauru"},
+	"NZ": {"New Zealand", "Aotearoa"},
+	"OM": {"Sultanate of Oman"},
+	"PA": {"Republic of Panama"},
+	"PE": {"Republic of Peru"},
+	"PG": {"Independent State of Papua New Guinea"},
+	"PH": {"Republic of the Philippines"},
+	"PK": {"Islamic Republic of Pakistan"},
+	"PL": {"Republic of Poland"},
+	"PM": {"Saint Pierre and Miquelon"},
+	"PT": {"Portuguese Republic"},
+	"PW": {"Republic of Palau"},
+	"PY": {"Republic of Paraguay"},
+	"QA": {"State of Qatar"},
+	"RE": {"Réunion"},
+	"RU": {"Russia"},
+	"RW": {"Rwandese Republic"},
+	"SA": {"Kingdom of Saudi Arabia"},
+	"SC": {"Republic of Seychelles"},
+	"SD": {"Republic of the Sudan"},
+	"SE": {"Kingdom of Sweden"},
+	"SG": {"Republic of Singapore"},
+	"SH": {"Saint Helena, Ascension and Tristan da Cunha", "Saint Helena"},
+	"SI": {"Republic of Slovenia"},
+	"SJ": {"Svalbard and Jan Mayen", "Svalbard"},
+	"SK": {"Slovak Republic"},
+	"SL": {"Republic of Sierra Leone"},
+	"SM": {"Republic of San Marino"},
+	"SN": {"Republic of Senegal"},
+	"SO": {"Federal Republic of Somalia"},
+	"SR": {"Republic of Suriname", "Surinam"},
+	"ST": {"Democratic Republic of Sao Tome and Principe"},
+	"SV": {"Republic of El Salvador"},
+	"SY": {"Syria"},
+	"SZ": {"Eswatini", "Kingdom of Eswatini"},
+	"TD": {"Republic of Chad"},
+	"TG": {"Togolese Republic"},
+	"TH": {"Kingdom of Thailand", "Siam"},
+	"TJ": {"Republic of Tajikistan"},
+	"TN": {"Republic of Tunisia"},
+	"TO": {"Kingdom of Tonga"},
+	"TP": {"Timor-Leste", "Timor Leste"},
+	"TR": {"Türkiye", "Republic of Türkiye", "Turkiye"},
+	"TT": {"Republic of Trinidad and Tobago"},
+	"TW": {"Taiwan, Province of China", "Republic of China"},
+	"TZ": {"Tanzania, United Republic of", "United Republic of Tanzania"},
+	"UG": {"Republic of Uganda"},
+	"US": {"United States of America", "USA", "U.S.A.", "U.S.", "America"},
+	"UY": {"Eastern Republic of Uruguay"},
+	"UZ": {"Republic of Uzbekistan"},
+	"VA": {"Holy See (Vatican City State)", "Vatican", "Vatican City", "Holy See"},
+	"VC": {"Saint Vincent and the Grenadines", "Saint Vincent"},
+	"VE": {"Venezuela, Bolivarian Republic of", "Bolivarian Republic of Venezuela"},
+	"VG": {"Virgin Islands, British", "British Virgin Islands", "BVI"},
+	"VI": {"Virgin Islands, U.S.", "Virgin Islands of the United States", "US Virgin Islands", "USVI"},
+	"VN": {"Socialist Republic of Viet Nam", "Vietnam"},
+	"VU": {"Republic of Vanuatu"},
+	"WF": {"Wallis and Futuna"},
+	"WS": {"Independent State of Samoa"},
+	"YE": {"Republic of Yemen"},
+	"ZA": {"Republic of South Africa"},
+	"ZM": {"Republic of Zambia"},
+	"ZW": {"Republic of Zimbabwe", "Rhodesia"},
+}
+
+// diacriticFolds maps accented letters to their unaccented spelling.
+var diacriticFolds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'č': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ğ': "g", 'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'ı': "i",
+	'ł': "l", 'ñ': "n", 'ń': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ő': "o", 'œ': "oe",
+	'ř': "r", 'ś': "s", 'ş': "s", 'ș': "s", 'š': "s", 'ß': "ss", 'ţ': "t", 'ț': "t", 'ť': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ů': "u", 'ű': "u",
+	'ý': "y", 'ÿ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// tokenRewrites maps abbreviated words to their expanded form; an empty value drops the word.
+var tokenRewrites = map[string]string{
+	"st":   "saint",
+	"ste":  "sainte",
+	"isls": "islands",
+	"is":   "islands",
+	"the":  "",
+}
+
+// nameEntry is a normalized country name or alias.
+type nameEntry struct {
+	countryCode string
+	name        string
+	normalized  string
+}
+
+// indexNames adds the name and aliases of a country to the name index of the registry.
+func (r *Registry) indexNames(country CountryContinent) {
+	names := append([]string{country.CountryName}, countryAliases[country.CountryCode]...)
+	for _, name := range names {
+		normalized := normalizeName(name)
+		r.nameEntries = append(r.nameEntries, nameEntry{countryCode: country.CountryCode, name: name, normalized: normalized})
+		if _, ok := r.nameMap[normalized]; !ok {
+			r.nameMap[normalized] = country.CountryCode
+		}
+	}
+}
+
+// normalizeName lower-cases a name, removes diacritics and punctuation, and expands common abbreviations.
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if fold, ok := diacriticFolds[r]; ok {
+			b.WriteString(fold)
+			continue
+		}
+		switch {
+		case r == '&':
+			b.WriteString(" and ")
+		case r == '\'' || r == '’' || r == '.':
+			// Dropped so that "D'Ivoire" and "U.S.A." collapse to a single word.
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	words := strings.Fields(b.String())
+	normalized := words[:0]
+	for _, word := range words {
+		if rewrite, ok := tokenRewrites[word]; ok {
+			if rewrite == "" {
+				continue
+			}
+			word = rewrite
+		}
+		normalized = append(normalized, word)
+	}
+	return strings.Join(normalized, " ")
+}
+
+// CountryCodeFromName returns the alpha-2 country code of the country with the given name.
+// The name is matched against country names and aliases, ignoring case, diacritics and punctuation.
+func (r *Registry) CountryCodeFromName(name string) (string, error) {
+	countryCode, ok := r.nameMap[normalizeName(name)]
+	if !ok {
+		return "", &CountryNameNotFoundError{Name: name}
+	}
+	return countryCode, nil
+}
+
+// CountryCodeFromNameFuzzy returns the countries whose name or alias resembles the given name,
+// best match first. At most limit matches are returned; a limit of zero or less returns all of them.
+func (r *Registry) CountryCodeFromNameFuzzy(name string, limit int) []NameMatch {
+	query := normalizeName(name)
+	if query == "" {
+		return nil
+	}
+	best := make(map[string]NameMatch)
+	for _, entry := range r.nameEntries {
+		score := nameSimilarity(query, entry.normalized)
+		if score < fuzzyMinScore {
+			continue
+		}
+		if current, ok := best[entry.countryCode]; ok && current.Score >= score {
+			continue
+		}
+		best[entry.countryCode] = NameMatch{
+			CountryCode: entry.countryCode,
+			CountryName: r.countryMap[entry.countryCode].CountryName,
+			MatchedName: entry.name,
+			Score:       score,
+		}
+	}
+	matches := make([]NameMatch, 0, len(best))
+	for _, match := range best {
+		matches = append(matches, match)
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].CountryCode < matches[j].CountryCode
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// CountryCodeFromName returns the alpha-2 country code of the country with the given name.
+// The name is matched against country names and aliases, ignoring case, diacritics and punctuation.
+func CountryCodeFromName(name string) (string, error) {
+	return defaultRegistry.CountryCodeFromName(name)
+}
+
+// CountryCodeFromNameFuzzy returns the countries whose name or alias resembles the given name,
+// best match first. At most limit matches are returned; a limit of zero or less returns all of them.
+func CountryCodeFromNameFuzzy(name string, limit int) []NameMatch {
+	return defaultRegistry.CountryCodeFromNameFuzzy(name, limit)
+}
+
+// nameSimilarity scores how close a normalized query is to a normalized candidate name, from 0 to 1.
+func nameSimilarity(query, candidate string) float64 {
+	if query == candidate {
+		return 1
+	}
+	q, c := []rune(query), []rune(candidate)
+	longest := max(len(q), len(c))
+	score := 1 - float64(levenshtein(q, c))/float64(longest)
+	coverage := float64(len(q)) / float64(len(c))
+	if strings.HasPrefix(candidate, query+" ") {
+		score = max(score, 0.75+0.2*coverage)
+	} else if strings.Contains(" "+candidate+" ", " "+query+" ") {
+		score = max(score, 0.7+0.2*coverage)
+	}
+	return score
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,116 @@
+package countrycontinent
+
+import "testing"
+
+func TestCountryCodeFromName(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		want          string
+		expectedError error
+	}{
+		{name: "Canonical name", input: "Viet Nam", want: "VN", expectedError: nil},
+		{name: "Common short name", input: "Vietnam", want: "VN", expectedError: nil},
+		{name: "Name with diacritics", input: "Côte d'Ivoire", want: "CI", expectedError: nil},
+		{name: "Diacritic-free spelling", input: "Cote d'Ivoire", want: "CI", expectedError: nil},
+		{name: "English name", input: "Ivory Coast", want: "CI", expectedError: nil},
+		{name: "Official name", input: "French Republic", want: "FR", expectedError: nil},
+		{name: "Former name", input: "Burma", want: "MM", expectedError: nil},
+		{name: "ISO inverted name", input: "Korea, Republic of", want: "KR", expectedError: nil},
+		{name: "Abbreviation", input: "USA", want: "US", expectedError: nil},
+		{name: "Dotted abbreviation", input: "U.K.", want: "GB", expectedError: nil},
+		{name: "Saint expanded", input: "Saint Pierre and Miquelon", want: "PM", expectedError: nil},
+		{name: "Saint abbreviated", input: "St Helena", want: "SH", expectedError: nil},
+		{name: "Case and spacing", input: "  united   STATES ", want: "US", expectedError: nil},
+		{name: "Leading article", input: "The Bahamas", want: "BS", expectedError: nil},
+		{name: "Unknown name", input: "Atlantis", want: "", expectedError: &CountryNameNotFoundError{Name: "Atlantis"}},
+		{name: "Empty name", input: "", want: "", expectedError: &CountryNameNotFoundError{Name: ""}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryCodeFromName(tc.input)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryCodeFromName(%q) error = %v, wantError %v", tc.input, err, tc.expectedError)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("CountryCodeFromName(%q) = %s; want %s", tc.input, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCodeFromNameFuzzy(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		limit    int
+		wantBest string
+		wantLen  int
+	}{
+		{name: "Exact match", input: "Germany", limit: 1, wantBest: "DE", wantLen: 1},
+		{name: "Typo", input: "Germny", limit: 3, wantBest: "DE"},
+		{name: "Transposed letters", input: "Untied States", limit: 3, wantBest: "US"},
+		{name: "Misspelled alias", input: "Ivory Cost", limit: 3, wantBest: "CI"},
+		{name: "Prefix", input: "Guinea", limit: 3, wantBest: "GN", wantLen: 3},
+		{name: "No match", input: "Xyzzy", limit: 3, wantBest: "", wantLen: 0},
+		{name: "Empty query", input: "", limit: 3, wantBest: "", wantLen: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := CountryCodeFromNameFuzzy(tc.input, tc.limit)
+			if tc.wantBest == "" {
+				if len(got) != 0 {
+					t.Errorf("CountryCodeFromNameFuzzy(%q) = %v; want no matches", tc.input, got)
+				}
+				return
+			}
+			if len(got) == 0 || got[0].CountryCode != tc.wantBest {
+				t.Fatalf("CountryCodeFromNameFuzzy(%q) = %v; want best match %s", tc.input, got, tc.wantBest)
+			}
+			if tc.wantLen != 0 && len(got) != tc.wantLen {
+				t.Errorf("CountryCodeFromNameFuzzy(%q) returned %d matches; want %d", tc.input, len(got), tc.wantLen)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].Score > got[i-1].Score {
+					t.Errorf("CountryCodeFromNameFuzzy(%q) is not ranked by score: %v", tc.input, got)
+				}
+			}
+		})
+	}
+}
+
+func TestCountryAliasesUnambiguous(t *testing.T) {
+	seen := make(map[string]string)
+	for _, entry := range defaultRegistry.nameEntries {
+		if code, ok := seen[entry.normalized]; ok && code != entry.countryCode {
+			t.Errorf("name %q is used by both %s and %s", entry.name, code, entry.countryCode)
+		}
+		seen[entry.normalized] = entry.countryCode
+	}
+	for code := range countryAliases {
+		if _, ok := defaultRegistry.countryMap[code]; !ok {
+			t.Errorf("aliases defined for unknown country code %s", code)
+		}
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Côte d'Ivoire", want: "cote divoire"},
+		{input: "Réunion", want: "reunion"},
+		{input: "Türkiye", want: "turkiye"},
+		{input: "St. Vincent & the Grenadines", want: "saint vincent and grenadines"},
+		{input: "Guinea-Bissau", want: "guinea bissau"},
+		{input: "Korea, Republic of", want: "korea republic of"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			if got := normalizeName(tc.input); got != tc.want {
+				t.Errorf("normalizeName(%q) = %q, want %q", tc.input, got, tc.want)
+			}
+		})
+	}
+}
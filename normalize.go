@@ -0,0 +1,58 @@
+package countrycontinent
+
+import "strings"
+
+// nonISOCodes maps country codes that are in common use but are not the assigned ISO 3166-1
+// alpha-2 code (exceptionally reserved, transitional or EU-specific codes) to the code used in the table.
+var nonISOCodes = map[string]string{
+	"UK": "GB", // United Kingdom
+	"EL": "GR", // Greece, as used by the European Union
+	"FX": "FR", // Metropolitan France
+	"CP": "FR", // Clipperton Island
+	"IC": "ES", // Canary Islands
+	"EA": "ES", // Ceuta and Melilla
+	"AC": "SH", // Ascension Island
+	"TA": "SH", // Tristan da Cunha
+	"DG": "IO", // Diego Garcia
+	"TL": "TP", // Timor-Leste, listed under its former code
+	"BU": "MM", // Burma
+	"ZR": "CD", // Zaire
+}
+
+// currentCodes holds the current ISO 3166-1 alpha-2 code of countries the table lists under a
+// former code, as used in flag emoji and BCP 47 region subtags.
+var currentCodes = map[string]string{
+	"TP": "TL", // Timor-Leste
+}
+
+// Normalize returns the canonical alpha-2 country code for a leniently formatted country code.
+// Surrounding whitespace is trimmed, letters are upper-cased, well-known non-ISO codes such as
+// "UK" and "EL" are mapped to their ISO equivalent unless the registry lists them, and alpha-3 and
+// numeric codes are converted to alpha-2. The strict lookup functions are unaffected and still reject
+// such input.
+func (r *Registry) Normalize(countryCode string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(countryCode))
+	if iso, ok := nonISOCodes[code]; ok {
+		// A registry may list the code itself, such as Timor-Leste under "TL".
+		if _, listed := r.countryMap[code]; !listed {
+			code = iso
+		}
+	}
+	if !isValidCountryCode(code) && !isValidAlpha3Code(code) && !isValidNumericCode(code) {
+		return "", &InvalidCountryCodeError{CountryCode: countryCode}
+	}
+	country, err := r.CountryLookup(code)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryCode, nil
+}
+
+// Normalize returns the canonical alpha-2 country code for a leniently formatted country code.
+// Surrounding whitespace is trimmed, letters are upper-cased, well-known non-ISO codes such as
+// "UK" and "EL" are mapped to their ISO equivalent unless the registry lists them, and alpha-3 and
+// numeric codes are converted to alpha-2. The strict lookup functions are unaffected and still reject
+// such input.
+func Normalize(countryCode string) (string, error) {
+	return defaultRegistry.Normalize(countryCode)
+}
@@ -0,0 +1,76 @@
+package countrycontinent
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "Canonical code", code: "FR", want: "FR", expectedError: nil},
+		{name: "Lowercase", code: "fr", want: "FR", expectedError: nil},
+		{name: "Mixed case", code: "Us", want: "US", expectedError: nil},
+		{name: "Surrounding spaces", code: " US ", want: "US", expectedError: nil},
+		{name: "Trailing newline", code: "us\n", want: "US", expectedError: nil},
+		{name: "UK maps to GB", code: "UK", want: "GB", expectedError: nil},
+		{name: "Lowercase uk maps to GB", code: "uk", want: "GB", expectedError: nil},
+		{name: "EL maps to GR", code: "EL", want: "GR", expectedError: nil},
+		{name: "Alpha-3 code", code: "fra", want: "FR", expectedError: nil},
+		{name: "Numeric code", code: " 250 ", want: "FR", expectedError: nil},
+		{name: "Timor-Leste alpha-3", code: "tls", want: "TP", expectedError: nil},
+		{name: "Unknown code", code: "xx", want: "", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Empty code", code: "  ", want: "", expectedError: &InvalidCountryCodeError{CountryCode: "  "}},
+		{name: "Contains number", code: "u1", want: "", expectedError: &InvalidCountryCodeError{CountryCode: "u1"}},
+		{name: "Inner space", code: "U S", want: "", expectedError: &InvalidCountryCodeError{CountryCode: "U S"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Normalize(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("Normalize(%q) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("Normalize(%q) = %s; want %s", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeListedCode(t *testing.T) {
+	r, err := NewRegistry([]CountryContinent{
+		{CountryCode: "TL", CountryName: "Timor-Leste", Continent: "Asia"},
+		{CountryCode: "GB", CountryName: "United Kingdom", Continent: "Europe"},
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	tests := []struct {
+		code string
+		want string
+	}{
+		{code: "TL", want: "TL"},
+		{code: "tl", want: "TL"},
+		{code: "UK", want: "GB"},
+	}
+	for _, tc := range tests {
+		if got, err := r.Normalize(tc.code); got != tc.want || err != nil {
+			t.Errorf("Normalize(%q) = %s, %v; want %s, nil", tc.code, got, err, tc.want)
+		}
+	}
+	if _, err := r.Normalize("TP"); !sameError(err, &CountryNotFoundError{CountryCode: "TP"}) {
+		t.Errorf("Normalize(TP) error = %v; want country code not found: TP", err)
+	}
+}
+
+func TestNonISOCodesTargetKnownCountries(t *testing.T) {
+	for code, iso := range nonISOCodes {
+		if _, ok := defaultRegistry.countryMap[code]; ok {
+			t.Errorf("non-ISO code %s shadows a country in the table", code)
+		}
+		if _, ok := defaultRegistry.countryMap[iso]; !ok {
+			t.Errorf("non-ISO code %s maps to unknown country code %s", code, iso)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PatchOp is the operation of a Patch.
+type PatchOp int
+
+// Patch operations.
+const (
+	PatchAdd    PatchOp = iota + 1 // Add a country that is not in the registry
+	PatchModify                    // Replace the non-empty fields of a country in the registry
+	PatchRemove                    // Remove a country from the registry
+)
+
+func (op PatchOp) String() string {
+	switch op {
+	case PatchAdd:
+		return "add"
+	case PatchModify:
+		return "modify"
+	case PatchRemove:
+		return "remove"
+	}
+	return fmt.Sprintf("PatchOp(%d)", int(op))
+}
+
+// Patch is a change applied to a registry by Apply. Countries are identified by their alpha-2 CountryCode.
+type Patch struct {
+	Op      PatchOp
+	Country CountryContinent
+}
+
+// AddCountry returns a patch adding a country.
+func AddCountry(country CountryContinent) Patch {
+	return Patch{Op: PatchAdd, Country: country}
+}
+
+// ModifyCountry returns a patch replacing the non-empty fields of the country with the same country code.
+func ModifyCountry(country CountryContinent) Patch {
+	return Patch{Op: PatchModify, Country: country}
+}
+
+// RemoveCountry returns a patch removing the country with the given country code.
+func RemoveCountry(countryCode string) Patch {
+	return Patch{Op: PatchRemove, Country: CountryContinent{CountryCode: countryCode}}
+}
+
+// PatchError is returned when a patch cannot be applied.
+type PatchError struct {
+	Index int   // 0-based index of the patch
+	Patch Patch // Patch that failed
+	Err   error // Reason the patch failed
+}
+
+func (e *PatchError) Error() string {
+	return fmt.Sprintf("patch %d (%s %s): %v", e.Index, e.Patch.Op, e.Patch.Country.CountryCode, e.Err)
+}
+
+func (e *PatchError) Unwrap() error {
+	return e.Err
+}
+
+// Apply returns a new registry with the given patches applied in order on top of the countries of r.
+// Modified countries keep their position, added countries are appended, and all indexes are rebuilt.
+// r itself is left unchanged.
+func (r *Registry) Apply(patches ...Patch) (*Registry, error) {
+	countries := r.Countries()
+	index := make(map[string]int, len(countries))
+	for i, country := range countries {
+		index[country.CountryCode] = i
+	}
+
+	for i, patch := range patches {
+		code := patch.Country.CountryCode
+		if !isValidCountryCode(code) {
+			return nil, &PatchError{Index: i, Patch: patch, Err: &InvalidCountryCodeError{CountryCode: code}}
+		}
+		pos, exists := index[code]
+		switch patch.Op {
+		case PatchAdd:
+			if exists {
+				return nil, &PatchError{Index: i, Patch: patch, Err: errors.New("country code already exists")}
+			}
+			index[code] = len(countries)
+			countries = append(countries, patch.Country)
+		case PatchModify:
+			if !exists {
+				return nil, &PatchError{Index: i, Patch: patch, Err: &CountryNotFoundError{CountryCode: code}}
+			}
+			countries[pos] = mergeCountry(countries[pos], patch.Country)
+		case PatchRemove:
+			if !exists {
+				return nil, &PatchError{Index: i, Patch: patch, Err: &CountryNotFoundError{CountryCode: code}}
+			}
+			countries = append(countries[:pos], countries[pos+1:]...)
+			delete(index, code)
+			for j := pos; j < len(countries); j++ {
+				index[countries[j].CountryCode] = j
+			}
+		default:
+			return nil, &PatchError{Index: i, Patch: patch, Err: errors.New("unknown patch operation")}
+		}
+	}
+	return NewRegistry(countries)
+}
+
+// mergeCountry returns country with the non-empty fields of patch applied.
+func mergeCountry(country, patch CountryContinent) CountryContinent {
+	if patch.CountryName != "" {
+		country.CountryName = patch.CountryName
+	}
+	if patch.Continent != "" {
+		country.Continent = patch.Continent
+	}
+	if patch.CountryCodeAlpha3 != "" {
+		country.CountryCodeAlpha3 = patch.CountryCodeAlpha3
+	}
+	if patch.CountryCodeNumeric != "" {
+		country.CountryCodeNumeric = patch.CountryCodeNumeric
+	}
+	return country
+}
+
+// NewOverlay returns a new registry with the given patches applied on top of the embedded country table.
+func NewOverlay(patches ...Patch) (*Registry, error) {
+	return defaultRegistry.Apply(patches...)
+}
@@ -0,0 +1,104 @@
+package countrycontinent
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewOverlay(t *testing.T) {
+	r, err := NewOverlay(
+		ModifyCountry(CountryContinent{CountryCode: "VN", CountryName: "Vietnam"}),
+		ModifyCountry(CountryContinent{CountryCode: "KR", CountryName: "South Korea"}),
+		ModifyCountry(CountryContinent{CountryCode: "MX", Continent: "Central America"}),
+		AddCountry(CountryContinent{CountryCode: "XK", CountryName: "Kosovo", Continent: "Europe", CountryCodeAlpha3: "XKX"}),
+		RemoveCountry("TP"),
+	)
+	if err != nil {
+		t.Fatalf("NewOverlay() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		code          string
+		wantName      string
+		wantContinent string
+		expectedError error
+	}{
+		{name: "Renamed", code: "VN", wantName: "Vietnam", wantContinent: "Asia"},
+		{name: "Renamed to short name", code: "KR", wantName: "South Korea", wantContinent: "Asia"},
+		{name: "Continent reassigned", code: "MX", wantName: "Mexico", wantContinent: "Central America"},
+		{name: "Added", code: "XK", wantName: "Kosovo", wantContinent: "Europe"},
+		{name: "Untouched", code: "FR", wantName: "France", wantContinent: "Europe"},
+		{name: "Removed", code: "TP", expectedError: &CountryNotFoundError{CountryCode: "TP"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotName, gotContinent, err := r.CountryGetFullNameContinent(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetFullNameContinent(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err == nil && (gotName != tc.wantName || gotContinent != tc.wantContinent) {
+				t.Errorf("CountryGetFullNameContinent(%s) = %s, %s; want %s, %s", tc.code, gotName, gotContinent, tc.wantName, tc.wantContinent)
+			}
+		})
+	}
+
+	northAmerica, _ := r.ContinentGetCountries("North America")
+	if StringInSlice("MX", northAmerica) {
+		t.Errorf("ContinentGetCountries(North America) still lists MX: %v", northAmerica)
+	}
+	centralAmerica, _ := r.ContinentGetCountries("Central America")
+	if !StringInSlice("MX", centralAmerica) {
+		t.Errorf("ContinentGetCountries(Central America) is missing MX: %v", centralAmerica)
+	}
+	asia, _ := r.ContinentGetCountries("Asia")
+	if StringInSlice("TP", asia) {
+		t.Errorf("ContinentGetCountries(Asia) still lists removed TP: %v", asia)
+	}
+	if code, _ := r.CountryCodeFromName("Kosovo"); code != "XK" {
+		t.Errorf("CountryCodeFromName(Kosovo) = %s; want XK", code)
+	}
+	if code, _ := r.Alpha3ToAlpha2("VNM"); code != "VN" {
+		t.Errorf("Alpha3ToAlpha2(VNM) = %s; want VN", code)
+	}
+
+	if name, _ := CountryGetFullName("VN"); name != "Viet Nam" {
+		t.Errorf("default registry changed by overlay: CountryGetFullName(VN) = %s", name)
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		patches []Patch
+		wantErr error
+	}{
+		{name: "Add existing", patches: []Patch{AddCountry(CountryContinent{CountryCode: "FR", CountryName: "France", Continent: "Europe"})}},
+		{name: "Modify missing", patches: []Patch{ModifyCountry(CountryContinent{CountryCode: "XK", CountryName: "Kosovo"})}, wantErr: &CountryNotFoundError{CountryCode: "XK"}},
+		{name: "Remove missing", patches: []Patch{RemoveCountry("XK")}, wantErr: &CountryNotFoundError{CountryCode: "XK"}},
+		{name: "Remove twice", patches: []Patch{RemoveCountry("FR"), RemoveCountry("FR")}, wantErr: &CountryNotFoundError{CountryCode: "FR"}},
+		{name: "Invalid code", patches: []Patch{RemoveCountry("fr")}, wantErr: &InvalidCountryCodeError{CountryCode: "fr"}},
+		{name: "Unknown operation", patches: []Patch{{Country: CountryContinent{CountryCode: "FR"}}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewOverlay(tc.patches...)
+			var patchErr *PatchError
+			if !errors.As(err, &patchErr) {
+				t.Fatalf("NewOverlay() error = %v, want a *PatchError", err)
+			}
+			if patchErr.Index != len(tc.patches)-1 {
+				t.Errorf("PatchError.Index = %d, want %d", patchErr.Index, len(tc.patches)-1)
+			}
+			if tc.wantErr != nil && !sameError(patchErr.Err, tc.wantErr) {
+				t.Errorf("PatchError.Err = %v, want %v", patchErr.Err, tc.wantErr)
+			}
+		})
+	}
+
+	_, err := NewOverlay(ModifyCountry(CountryContinent{CountryCode: "FR", Continent: "Atlantis"}))
+	var datasetErr *DatasetError
+	if !errors.As(err, &datasetErr) {
+		t.Errorf("NewOverlay() with unknown continent error = %v, want a *DatasetError", err)
+	}
+}
@@ -0,0 +1,144 @@
+package countrycontinent
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Registry holds a country dataset and the indexes used to look countries up.
+// A Registry is immutable once built and safe for concurrent use.
+type Registry struct {
+	countries    []CountryContinent
+	sortedCodes  []string
+	countryMap   map[string]CountryContinent
+	continentMap map[string][]string
+	alpha3Map    map[string]string
+	numericMap   map[string]string
+	nameEntries  []nameEntry
+	nameMap      map[string]string
+	version      string
+	boundaries   func() (*Boundaries, error) // Embedded country boundaries, loaded on first use
+}
+
+// defaultRegistry is built from the embedded country table and backs the package-level functions.
+var defaultRegistry = mustNewRegistry(countryContinent)
+
+// DefaultRegistry returns the registry built from the embedded country table,
+// which backs the package-level lookup functions.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry builds a registry from the given countries. Every country needs a unique, well-formed
+// alpha-2 code, a name and one of the continents of AllContinents; alpha-3 and numeric codes are
+// optional but must be unique when given. A *DatasetError lists every problem found.
+func NewRegistry(countries []CountryContinent) (*Registry, error) {
+	return newRegistry(countries, nil)
+}
+
+// newRegistry builds a registry from the given countries, reporting validation problems
+// against the given source lines, which may be nil.
+func newRegistry(countries []CountryContinent, lines []int) (*Registry, error) {
+	if err := validateCountries(countries, lines); err != nil {
+		return nil, err
+	}
+	r := &Registry{
+		countries:    append([]CountryContinent(nil), countries...),
+		countryMap:   make(map[string]CountryContinent),
+		continentMap: make(map[string][]string),
+		alpha3Map:    make(map[string]string),
+		numericMap:   make(map[string]string),
+		nameMap:      make(map[string]string),
+	}
+	for _, country := range r.countries {
+		r.countryMap[country.CountryCode] = country
+		if country.CountryCodeAlpha3 != "" {
+			r.alpha3Map[country.CountryCodeAlpha3] = country.CountryCode
+		}
+		if country.CountryCodeNumeric != "" {
+			r.numericMap[country.CountryCodeNumeric] = country.CountryCode
+		}
+		r.indexNames(country)
+		r.sortedCodes = append(r.sortedCodes, country.CountryCode)
+	}
+	sort.Strings(r.sortedCodes)
+	for _, code := range r.sortedCodes {
+		continent := r.countryMap[code].Continent
+		r.continentMap[continent] = append(r.continentMap[continent], code)
+	}
+	r.version = datasetVersion(r.countries)
+	r.boundaries = sync.OnceValues(func() (*Boundaries, error) {
+		return r.LoadEmbeddedBoundaries(BoundaryOptions{})
+	})
+	return r, nil
+}
+
+// datasetVersion returns a short hash identifying the content of a dataset.
+func datasetVersion(countries []CountryContinent) string {
+	h := sha256.New()
+	for _, country := range countries {
+		fields := []string{country.CountryCode, country.CountryName, country.Continent, country.CountryCodeAlpha3, country.CountryCodeNumeric}
+		h.Write([]byte(strings.Join(fields, "\x00") + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// mustNewRegistry is like NewRegistry but panics if the countries are invalid.
+func mustNewRegistry(countries []CountryContinent) *Registry {
+	r, err := NewRegistry(countries)
+	if err != nil {
+		panic("countrycontinent: " + err.Error())
+	}
+	return r
+}
+
+// Version returns a short hash identifying the content of the registry. Registries holding the
+// same countries in the same order have the same version.
+func (r *Registry) Version() string {
+	return r.version
+}
+
+// Countries returns a copy of the countries held by the registry, in the order they were added.
+func (r *Registry) Countries() []CountryContinent {
+	return append([]CountryContinent(nil), r.countries...)
+}
+
+// CountryGetFullName returns the full name of the country with the given country code.
+func (r *Registry) CountryGetFullName(countryCode string) (string, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.CountryName, nil
+}
+
+// CountryGetFullNameContinent returns the full name and continent of the country with the given country code.
+func (r *Registry) CountryGetFullNameContinent(countryCode string) (string, string, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return "", "", err
+	}
+	return country.CountryName, country.Continent, nil
+}
+
+// CountryGetContinent returns the continent of a country from its country code.
+func (r *Registry) CountryGetContinent(countryCode string) (string, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return "", err
+	}
+	return country.Continent, nil
+}
+
+// ContinentGetCountries returns a list of countries in a continent from its continent name, sorted by
+// country code. The list is a copy that the caller may modify.
+func (r *Registry) ContinentGetCountries(continent string) ([]string, error) {
+	countries, ok := r.continentMap[continent]
+	if !ok {
+		return nil, continentNotFound(continent, r.continentNames())
+	}
+	return append([]string(nil), countries...), nil
+}
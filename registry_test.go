@@ -0,0 +1,189 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// testCountries is a small fixture dataset that disagrees with the embedded table on purpose.
+var testCountries = []CountryContinent{
+	{"FR", "French Republic", "Europe", "FRA", "250"},
+	{"VN", "Vietnam", "Asia", "VNM", "704"},
+	{"XK", "Kosovo", "Europe", "", ""},
+}
+
+func TestNewRegistry(t *testing.T) {
+	r, err := NewRegistry(testCountries)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		code          string
+		want          string
+		expectedError error
+	}{
+		{name: "Overridden name", code: "FR", want: "French Republic", expectedError: nil},
+		{name: "Code missing from the embedded table", code: "XK", want: "Kosovo", expectedError: nil},
+		{name: "Code missing from the fixture", code: "US", want: "", expectedError: &CountryNotFoundError{CountryCode: "US"}},
+		{name: "Invalid code", code: "fr", want: "", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := r.CountryGetFullName(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetFullName(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err == nil && got != tc.want {
+				t.Errorf("CountryGetFullName(%s) = %s; want %s", tc.code, got, tc.want)
+			}
+		})
+	}
+
+	europe, err := r.ContinentGetCountries("Europe")
+	if err != nil || !reflect.DeepEqual(europe, []string{"FR", "XK"}) {
+		t.Errorf("ContinentGetCountries(Europe) = %v, %v; want [FR XK]", europe, err)
+	}
+	if code, err := r.CountryCodeFromName("Kosovo"); err != nil || code != "XK" {
+		t.Errorf("CountryCodeFromName(Kosovo) = %s, %v; want XK", code, err)
+	}
+	if _, err := r.Alpha2ToAlpha3("XK"); err != nil {
+		t.Errorf("Alpha2ToAlpha3(XK) error = %v", err)
+	}
+	if name, _ := CountryGetFullName("FR"); name != "France" {
+		t.Errorf("default registry CountryGetFullName(FR) = %s; want France", name)
+	}
+}
+
+func TestNewRegistryErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		countries []CountryContinent
+	}{
+		{name: "Invalid alpha-2 code", countries: []CountryContinent{{"fr", "France", "Europe", "FRA", "250"}}},
+		{name: "Duplicate alpha-2 code", countries: []CountryContinent{{"FR", "France", "Europe", "", ""}, {"FR", "France", "Europe", "", ""}}},
+		{name: "Duplicate alpha-3 code", countries: []CountryContinent{{"FR", "France", "Europe", "FRA", ""}, {"FX", "France", "Europe", "FRA", ""}}},
+		{name: "Duplicate numeric code", countries: []CountryContinent{{"FR", "France", "Europe", "", "250"}, {"FX", "France", "Europe", "", "250"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewRegistry(tc.countries); err == nil {
+				t.Errorf("NewRegistry() error = nil, want an error")
+			}
+		})
+	}
+}
+
+func TestNewRegistryCopiesInput(t *testing.T) {
+	countries := append([]CountryContinent(nil), testCountries...)
+	r, err := NewRegistry(countries)
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+	countries[0].CountryName = "Changed"
+	if got := r.Countries()[0].CountryName; got != "French Republic" {
+		t.Errorf("registry changed with its input: CountryName = %s", got)
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	if DefaultRegistry() != defaultRegistry {
+		t.Fatal("DefaultRegistry() does not return the registry backing the package-level functions")
+	}
+	if got := len(DefaultRegistry().Countries()); got != len(countryContinent) {
+		t.Errorf("DefaultRegistry() holds %d countries, want %d", got, len(countryContinent))
+	}
+}
+
+func TestRegistryVersion(t *testing.T) {
+	a, _ := NewRegistry(testCountries)
+	b, _ := NewRegistry(testCountries)
+	if a.Version() != b.Version() {
+		t.Errorf("same dataset has versions %s and %s", a.Version(), b.Version())
+	}
+	if a.Version() == DefaultRegistry().Version() {
+		t.Errorf("different datasets share version %s", a.Version())
+	}
+	c, _ := a.Apply(ModifyCountry(CountryContinent{CountryCode: "VN", CountryName: "Viet Nam"}))
+	if c.Version() == a.Version() {
+		t.Errorf("patched dataset kept version %s", a.Version())
+	}
+}
+
+// mutate overwrites, reorders and appends to a list returned by the package.
+func mutate(list []string) {
+	for i := range list {
+		list[i] = "ZZ"
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(list)))
+	_ = append(list[:0], "XX")
+}
+
+func TestRegistryNotMutableThroughAPI(t *testing.T) {
+	snapshot := make(map[string][]string)
+	for continent, countries := range defaultRegistry.continentMap {
+		snapshot[continent] = append([]string(nil), countries...)
+	}
+	countries := defaultRegistry.Countries()
+
+	for continent := range snapshot {
+		got, err := ContinentGetCountries(continent)
+		if err != nil {
+			t.Fatalf("ContinentGetCountries(%s) error = %v", continent, err)
+		}
+		if !sort.StringsAreSorted(got) {
+			t.Errorf("ContinentGetCountries(%s) = %v; want sorted by country code", continent, got)
+		}
+		mutate(got)
+		typed, _ := ParseContinent(continent)
+		got, _ = ContinentGetCountriesTyped(typed)
+		mutate(got)
+		got, _ = ContinentGetCountriesModel(TableContinentModel, continent)
+		mutate(got)
+		got, _ = ContinentGetCountriesIncludingPartial(continent)
+		mutate(got)
+	}
+	for _, region := range AllRegions() {
+		got, _ := RegionGetCountries(region.Code)
+		mutate(got)
+	}
+	returned := defaultRegistry.Countries()
+	returned[0].CountryName = "Changed"
+
+	if !reflect.DeepEqual(defaultRegistry.continentMap, snapshot) {
+		t.Error("continent index changed through the returned lists")
+	}
+	if !reflect.DeepEqual(defaultRegistry.Countries(), countries) {
+		t.Error("countries changed through the returned lists")
+	}
+}
+
+func TestRegistryConcurrentUse(t *testing.T) {
+	want, _ := ContinentGetCountries("Europe")
+	want = append([]string(nil), want...)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				got, err := ContinentGetCountries("Europe")
+				if err != nil || !reflect.DeepEqual(got, want) {
+					t.Errorf("ContinentGetCountries(Europe) = %v, %v; want %v", got, err, want)
+					return
+				}
+				mutate(got)
+				if _, err := CountryLookup("FRA"); err != nil {
+					t.Errorf("CountryLookup(FRA) error = %v", err)
+				}
+				for range ContinentsSeq() {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
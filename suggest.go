@@ -0,0 +1,133 @@
+package countrycontinent
+
+import (
+	"sort"
+	"strings"
+)
+
+// Maximum number of suggestions carried by not-found errors.
+const (
+	maxCountrySuggestions   = 5
+	maxContinentSuggestions = 3
+)
+
+// countryNotFound returns a CountryNotFoundError for a code missing from the registry,
+// suggesting similar codes of the registry.
+func (r *Registry) countryNotFound(countryCode string) *CountryNotFoundError {
+	return &CountryNotFoundError{
+		CountryCode: countryCode,
+		suggest:     func() []string { return r.suggestCountryCodes(countryCode) },
+	}
+}
+
+// continentNotFound returns a ContinentNotFoundError for a continent that is not one of the
+// known continents, suggesting the closest known continents.
+func continentNotFound(continent string, known []string) *ContinentNotFoundError {
+	return &ContinentNotFoundError{
+		Continent: continent,
+		suggest:   func() []string { return suggestContinents(continent, known) },
+	}
+}
+
+// suggestCountryCodes returns the codes of the registry a caller most likely meant by countryCode:
+// the ISO code for a well-known non-ISO code, then countries whose name starts with the code,
+// then codes of the same form that differ by a single character.
+func (r *Registry) suggestCountryCodes(countryCode string) []string {
+	var suggestions []string
+	seen := make(map[string]bool)
+	add := func(code string) {
+		if !seen[code] && len(suggestions) < maxCountrySuggestions {
+			seen[code] = true
+			suggestions = append(suggestions, code)
+		}
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(countryCode))
+	if iso, ok := nonISOCodes[code]; ok {
+		if _, ok := r.countryMap[iso]; ok {
+			add(iso)
+		}
+	}
+
+	prefix := strings.ToLower(code)
+	var byName []string
+	for _, country := range r.countries {
+		if len(prefix) >= 2 && strings.HasPrefix(normalizeName(country.CountryName), prefix) {
+			byName = append(byName, country.CountryCode)
+		}
+	}
+	sort.Strings(byName)
+	for _, c := range byName {
+		add(c)
+	}
+
+	var near []string
+	for _, country := range r.countries {
+		for _, candidate := range []string{country.CountryCode, country.CountryCodeAlpha3, country.CountryCodeNumeric} {
+			if len(candidate) == len(code) && levenshtein([]rune(candidate), []rune(code)) == 1 {
+				near = append(near, country.CountryCode)
+			}
+		}
+	}
+	sort.Strings(near)
+	for _, c := range near {
+		add(c)
+	}
+	return suggestions
+}
+
+// suggestContinents returns the known continents closest to continent by edit distance, ignoring case.
+// A continent whose short code ("NA" for North America) is given comes first.
+func suggestContinents(continent string, known []string) []string {
+	query := []rune(strings.ToLower(strings.TrimSpace(continent)))
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, name := range known {
+		lower := strings.ToLower(name)
+		if code := continentCode(name); code != "" && strings.EqualFold(string(query), code) {
+			candidates = append(candidates, candidate{name: name, distance: -1})
+			continue
+		}
+		distance := levenshtein(query, []rune(lower))
+		if distance <= max(2, len(lower)/3) || (len(query) >= 3 && strings.HasPrefix(lower, string(query))) {
+			candidates = append(candidates, candidate{name: name, distance: distance})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+	var suggestions []string
+	for _, c := range candidates {
+		if len(suggestions) == maxContinentSuggestions {
+			break
+		}
+		suggestions = append(suggestions, c.name)
+	}
+	return suggestions
+}
+
+// continentCode returns the short code of the continent with the given name, or an empty string.
+func continentCode(name string) string {
+	for _, c := range AllContinents() {
+		if continentNames[c] == name {
+			return continentCodes[c]
+		}
+	}
+	return ""
+}
+
+// continentNames returns the continents of the registry, sorted by name.
+func (r *Registry) continentNames() []string {
+	names := make([]string, 0, len(r.continentMap))
+	for name := range r.continentMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
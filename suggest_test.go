@@ -0,0 +1,92 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCountryNotFoundSuggestions(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string
+	}{
+		{name: "Non-ISO code", code: "UK", want: []string{"GB", "UA", "CK", "DK", "FK"}},
+		{name: "Name prefix", code: "SP", want: []string{"ES", "GP", "JP", "KP", "MP"}},
+		{name: "Names before near codes", code: "SW", want: []string{"CH", "SE", "SZ", "AW", "BW"}},
+		{name: "Near alpha-3 codes", code: "FRX", want: []string{"FO", "FR"}},
+		{name: "Nothing close", code: "999", want: nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := CountryLookup(tc.code)
+			var notFound *CountryNotFoundError
+			if !errors.As(err, &notFound) {
+				t.Fatalf("CountryLookup(%s) error = %v, want a *CountryNotFoundError", tc.code, err)
+			}
+			if got := notFound.Suggestions(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Suggestions() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContinentNotFoundSuggestions(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(string) error
+		input  string
+		want   []string
+	}{
+		{name: "Misspelled", lookup: continentLookup, input: "Oceana", want: []string{"Oceania"}},
+		{name: "Transposed letters", lookup: continentLookup, input: "Eurpoe", want: []string{"Europe"}},
+		{name: "Wrong case", lookup: continentLookup, input: "north america", want: []string{"North America", "South America", "Central America"}},
+		{name: "Nothing close", lookup: continentLookup, input: "Mars", want: nil},
+		{name: "Short code", lookup: continentLookup, input: "NA", want: []string{"North America"}},
+		{name: "Lowercase short code", lookup: continentLookup, input: "oc", want: []string{"Oceania"}},
+		{name: "Short code near a name", lookup: continentLookup, input: "EU", want: []string{"Europe"}},
+		{name: "ParseContinent", lookup: parseContinentLookup, input: "Afrika", want: []string{"Africa"}},
+		{name: "Model continents", lookup: sevenContinentLookup, input: "North Amerika", want: []string{"North America", "South America"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.lookup(tc.input)
+			var notFound *ContinentNotFoundError
+			if !errors.As(err, &notFound) {
+				t.Fatalf("lookup(%s) error = %v, want a *ContinentNotFoundError", tc.input, err)
+			}
+			if got := notFound.Suggestions(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Suggestions() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func continentLookup(continent string) error {
+	_, err := ContinentGetCountries(continent)
+	return err
+}
+
+func parseContinentLookup(continent string) error {
+	_, err := ParseContinent(continent)
+	return err
+}
+
+func sevenContinentLookup(continent string) error {
+	_, err := ContinentGetCountriesModel(SevenContinentModel, continent)
+	return err
+}
+
+func TestSuggestionsThroughWrapping(t *testing.T) {
+	_, err := CountryGetFullName("UK")
+	wrapped := fmt.Errorf("row 3: %w", err)
+	var suggester interface{ Suggestions() []string }
+	if !errors.As(wrapped, &suggester) || len(suggester.Suggestions()) == 0 || suggester.Suggestions()[0] != "GB" {
+		t.Errorf("wrapped error %v does not suggest GB", wrapped)
+	}
+	if got := (&CountryNotFoundError{CountryCode: "UK"}).Suggestions(); got != nil {
+		t.Errorf("Suggestions() of a hand-built error = %v, want nil", got)
+	}
+}
@@ -0,0 +1,81 @@
+package countrycontinent
+
+import (
+	"errors"
+	"fmt"
+)
+
+//go:generate go run gen_timezones.go
+
+// ErrTimeZoneNotFound matches TimeZoneNotFoundError with errors.Is.
+var ErrTimeZoneNotFound = errors.New("time zone not found")
+
+// TimeZoneNotFoundError is returned when an IANA time zone name is not found.
+// It matches ErrTimeZoneNotFound.
+type TimeZoneNotFoundError struct {
+	TimeZone string
+}
+
+func (e *TimeZoneNotFoundError) Error() string {
+	return fmt.Sprintf("time zone not found: %s", e.TimeZone)
+}
+
+// Is reports whether target is ErrTimeZoneNotFound.
+func (e *TimeZoneNotFoundError) Is(target error) bool {
+	return target == ErrTimeZoneNotFound
+}
+
+var timeZoneCountry map[string]string
+
+func init() {
+	tableCodes := make(map[string]string)
+	for code, current := range currentCodes {
+		tableCodes[current] = code
+	}
+	timeZoneCountry = make(map[string]string)
+	for countryCode, zones := range tzCountryTimeZones {
+		if code, ok := tableCodes[countryCode]; ok {
+			countryCode = code
+		}
+		for _, zone := range zones {
+			timeZoneCountry[zone] = countryCode
+		}
+	}
+}
+
+// CountryGetTimeZones returns the IANA time zones in use in a country, such as "Europe/Paris",
+// in the order of the tz database. It returns an empty list for countries without a time zone.
+func (r *Registry) CountryGetTimeZones(countryCode string) ([]string, error) {
+	if _, err := r.lookupAlpha2(countryCode); err != nil {
+		return nil, err
+	}
+	if current, ok := currentCodes[countryCode]; ok {
+		countryCode = current
+	}
+	return append([]string(nil), tzCountryTimeZones[countryCode]...), nil
+}
+
+// TimeZoneGetCountry returns the country code of the country using an IANA time zone, such as
+// "FR" for "Europe/Paris".
+func (r *Registry) TimeZoneGetCountry(timeZone string) (string, error) {
+	countryCode, ok := timeZoneCountry[timeZone]
+	if !ok {
+		return "", &TimeZoneNotFoundError{TimeZone: timeZone}
+	}
+	if _, ok := r.countryMap[countryCode]; !ok {
+		return "", r.countryNotFound(countryCode)
+	}
+	return countryCode, nil
+}
+
+// CountryGetTimeZones returns the IANA time zones in use in a country, such as "Europe/Paris",
+// in the order of the tz database.
+func CountryGetTimeZones(countryCode string) ([]string, error) {
+	return defaultRegistry.CountryGetTimeZones(countryCode)
+}
+
+// TimeZoneGetCountry returns the country code of the country using an IANA time zone, such as
+// "FR" for "Europe/Paris".
+func TimeZoneGetCountry(timeZone string) (string, error) {
+	return defaultRegistry.TimeZoneGetCountry(timeZone)
+}
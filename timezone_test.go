@@ -0,0 +1,94 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCountryGetTimeZones(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          []string
+		expectedError error
+	}{
+		{name: "France", code: "FR", want: []string{"Europe/Paris"}},
+		{name: "Several zones", code: "PT", want: []string{"Europe/Lisbon", "Atlantic/Madeira", "Atlantic/Azores"}},
+		{name: "Sweden has its own zone", code: "SE", want: []string{"Europe/Stockholm"}},
+		{name: "Former country code", code: "TP", want: []string{"Asia/Dili"}},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "fr", expectedError: &InvalidCountryCodeError{CountryCode: "fr"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetTimeZones(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetTimeZones(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CountryGetTimeZones(%s) = %v; want %v", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTimeZoneGetCountry(t *testing.T) {
+	tests := []struct {
+		name          string
+		timeZone      string
+		want          string
+		expectedError error
+	}{
+		{name: "Paris", timeZone: "Europe/Paris", want: "FR"},
+		{name: "Honolulu", timeZone: "Pacific/Honolulu", want: "US"},
+		{name: "Dili", timeZone: "Asia/Dili", want: "TP"},
+		{name: "Country missing from the table", timeZone: "Europe/Belgrade", expectedError: &CountryNotFoundError{CountryCode: "RS"}},
+		{name: "Unknown zone", timeZone: "Europe/Atlantis", expectedError: &TimeZoneNotFoundError{TimeZone: "Europe/Atlantis"}},
+		{name: "Zone without a country", timeZone: "UTC", expectedError: &TimeZoneNotFoundError{TimeZone: "UTC"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := TimeZoneGetCountry(tc.timeZone)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("TimeZoneGetCountry(%s) error = %v, wantError %v", tc.timeZone, err, tc.expectedError)
+			}
+			if got != tc.want {
+				t.Errorf("TimeZoneGetCountry(%s) = %s; want %s", tc.timeZone, got, tc.want)
+			}
+		})
+	}
+}
+
+// tzOnlyCountries lists the countries of the tz database that are missing from the country table.
+var tzOnlyCountries = []string{"AQ", "AX", "BL", "BQ", "BV", "CG", "CW", "GG", "HM", "IM", "JE", "ME", "MF", "PS", "RS", "SS", "SX"}
+
+func TestTimeZoneCountriesConsistent(t *testing.T) {
+	tableCodes := make(map[string]string)
+	for code, current := range currentCodes {
+		tableCodes[current] = code
+	}
+	var tzOnly []string
+	inTZ := make(map[string]bool)
+	for _, code := range tzCountryCodes {
+		if tableCode, ok := tableCodes[code]; ok {
+			code = tableCode
+		}
+		inTZ[code] = true
+		if _, ok := defaultRegistry.countryMap[code]; !ok {
+			tzOnly = append(tzOnly, code)
+		}
+	}
+	sort.Strings(tzOnly)
+	if !reflect.DeepEqual(tzOnly, tzOnlyCountries) {
+		t.Errorf("countries of the tz database missing from the table = %v; want %v", tzOnly, tzOnlyCountries)
+	}
+	for _, c := range countryContinent {
+		if !inTZ[c.CountryCode] {
+			t.Errorf("%s is missing from the tz database", c.CountryCode)
+		}
+		if zones, _ := CountryGetTimeZones(c.CountryCode); len(zones) == 0 {
+			t.Errorf("%s has no time zone", c.CountryCode)
+		}
+	}
+}
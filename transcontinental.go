@@ -0,0 +1,76 @@
+package countrycontinent
+
+import "sort"
+
+// ContinentMembership is a continent a country lies on.
+type ContinentMembership struct {
+	Continent string // Continent the country lies on
+	Primary   bool   // Whether this is the continent returned by CountryGetContinent
+}
+
+// transcontinentalCountries holds every continent a country spans, primary continent of the
+// country table first, keyed by alpha-2 country code. The continents besides a registry's primary
+// continent for the country are its partial memberships, so a registry that reassigns the country
+// keeps the continent it was moved from.
+var transcontinentalCountries = map[string][]string{
+	"AZ": {"Asia", "Europe"},                   // Northern slopes of the Greater Caucasus
+	"EG": {"Africa", "Asia"},                   // Sinai Peninsula
+	"ES": {"Europe", "Africa"},                 // Ceuta, Melilla and the Canary Islands
+	"GE": {"Asia", "Europe"},                   // Northern slopes of the Greater Caucasus
+	"ID": {"Asia", "Oceania"},                  // Western New Guinea
+	"KZ": {"Asia", "Europe"},                   // West of the Ural River
+	"PA": {"Central America", "South America"}, // East of the Panama Canal
+	"RU": {"Europe", "Asia"},                   // Siberia and the Russian Far East
+	"TR": {"Asia", "Europe"},                   // East Thrace
+	"YE": {"Asia", "Africa"},                   // Socotra
+}
+
+// CountryGetContinents returns all continents a country lies on, primary continent first.
+func (r *Registry) CountryGetContinents(countryCode string) ([]ContinentMembership, error) {
+	country, err := r.lookupAlpha2(countryCode)
+	if err != nil {
+		return nil, err
+	}
+	memberships := []ContinentMembership{{Continent: country.Continent, Primary: true}}
+	for _, continent := range transcontinentalCountries[countryCode] {
+		if continent == country.Continent {
+			continue
+		}
+		memberships = append(memberships, ContinentMembership{Continent: continent})
+	}
+	return memberships, nil
+}
+
+// ContinentGetCountriesIncludingPartial returns a list of countries in a continent followed by the
+// countries that lie partly on it but have another primary continent, each group sorted by country code.
+func (r *Registry) ContinentGetCountriesIncludingPartial(continent string) ([]string, error) {
+	countries, err := r.ContinentGetCountries(continent)
+	if err != nil {
+		return nil, err
+	}
+	var partial []string
+	for countryCode, continents := range transcontinentalCountries {
+		country, ok := r.countryMap[countryCode]
+		if !ok || country.Continent == continent {
+			continue
+		}
+		for _, c := range continents {
+			if c == continent {
+				partial = append(partial, countryCode)
+			}
+		}
+	}
+	sort.Strings(partial)
+	return append(countries, partial...), nil
+}
+
+// CountryGetContinents returns all continents a country lies on, primary continent first.
+func CountryGetContinents(countryCode string) ([]ContinentMembership, error) {
+	return defaultRegistry.CountryGetContinents(countryCode)
+}
+
+// ContinentGetCountriesIncludingPartial returns a list of countries in a continent followed by the
+// countries that lie partly on it but have another primary continent, each group sorted by country code.
+func ContinentGetCountriesIncludingPartial(continent string) ([]string, error) {
+	return defaultRegistry.ContinentGetCountriesIncludingPartial(continent)
+}
@@ -0,0 +1,104 @@
+package countrycontinent
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryGetContinents(t *testing.T) {
+	tests := []struct {
+		name          string
+		code          string
+		want          []ContinentMembership
+		expectedError error
+	}{
+		{name: "Single continent", code: "FR", want: []ContinentMembership{{Continent: "Europe", Primary: true}}},
+		{name: "Russia", code: "RU", want: []ContinentMembership{{Continent: "Europe", Primary: true}, {Continent: "Asia"}}},
+		{name: "Turkey", code: "TR", want: []ContinentMembership{{Continent: "Asia", Primary: true}, {Continent: "Europe"}}},
+		{name: "Egypt", code: "EG", want: []ContinentMembership{{Continent: "Africa", Primary: true}, {Continent: "Asia"}}},
+		{name: "Unknown code", code: "XX", expectedError: &CountryNotFoundError{CountryCode: "XX"}},
+		{name: "Invalid code", code: "ru", expectedError: &InvalidCountryCodeError{CountryCode: "ru"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := CountryGetContinents(tc.code)
+			if !sameError(err, tc.expectedError) {
+				t.Errorf("CountryGetContinents(%s) error = %v, wantError %v", tc.code, err, tc.expectedError)
+			}
+			if err == nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CountryGetContinents(%s) = %v; want %v", tc.code, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContinentGetCountriesIncludingPartial(t *testing.T) {
+	europe, err := ContinentGetCountriesIncludingPartial("Europe")
+	if err != nil {
+		t.Fatalf("ContinentGetCountriesIncludingPartial(Europe) error = %v", err)
+	}
+	for _, code := range []string{"FR", "RU", "TR", "KZ", "AZ", "GE"} {
+		if !StringInSlice(code, europe) {
+			t.Errorf("ContinentGetCountriesIncludingPartial(Europe) is missing %s", code)
+		}
+	}
+	full, _ := ContinentGetCountries("Europe")
+	if len(europe) != len(full)+4 {
+		t.Errorf("ContinentGetCountriesIncludingPartial(Europe) returned %d countries, want %d", len(europe), len(full)+4)
+	}
+	if got, _ := CountryGetContinent("TR"); got != "Asia" {
+		t.Errorf("CountryGetContinent(TR) = %s; want primary continent Asia", got)
+	}
+	if _, err := ContinentGetCountriesIncludingPartial("Mars"); !sameError(err, &ContinentNotFoundError{Continent: "Mars"}) {
+		t.Errorf("ContinentGetCountriesIncludingPartial(Mars) error = %v, want ContinentNotFoundError", err)
+	}
+}
+
+func TestTranscontinentalCountriesConsistent(t *testing.T) {
+	for code, continents := range transcontinentalCountries {
+		country, ok := defaultRegistry.countryMap[code]
+		if !ok {
+			t.Errorf("transcontinental country %s is not in the table", code)
+			continue
+		}
+		if len(continents) < 2 || continents[0] != country.Continent {
+			t.Errorf("%s: continents %v do not start with primary continent %s and another one", code, continents, country.Continent)
+		}
+		for _, continent := range continents {
+			if _, ok := defaultRegistry.continentMap[continent]; !ok {
+				t.Errorf("%s: unknown continent %s", code, continent)
+			}
+		}
+	}
+}
+
+func TestTranscontinentalOverlay(t *testing.T) {
+	r, err := NewOverlay(ModifyCountry(CountryContinent{CountryCode: "TR", Continent: "Europe"}))
+	if err != nil {
+		t.Fatalf("NewOverlay() error = %v", err)
+	}
+	got, err := r.CountryGetContinents("TR")
+	if want := []ContinentMembership{{Continent: "Europe", Primary: true}, {Continent: "Asia"}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("CountryGetContinents(TR) = %v, %v; want %v", got, err, want)
+	}
+	europe, err := r.ContinentGetCountriesIncludingPartial("Europe")
+	if err != nil {
+		t.Fatalf("ContinentGetCountriesIncludingPartial(Europe) error = %v", err)
+	}
+	count := 0
+	for _, code := range europe {
+		if code == "TR" {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("ContinentGetCountriesIncludingPartial(Europe) lists TR %d times, want once", count)
+	}
+	asia, err := r.ContinentGetCountriesIncludingPartial("Asia")
+	if err != nil {
+		t.Fatalf("ContinentGetCountriesIncludingPartial(Asia) error = %v", err)
+	}
+	if !StringInSlice("TR", asia) {
+		t.Errorf("ContinentGetCountriesIncludingPartial(Asia) = %v; want it to include TR", asia)
+	}
+}